@@ -1,17 +1,20 @@
 package bittrex
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/gorilla/websocket"
 )
 
 const (
@@ -20,97 +23,151 @@ const (
 )
 
 type Client struct {
-	apiKey      string
-	apiSecret   string
-	httpClient  *http.Client
-	httpTimeout time.Duration
-	debug       bool
+	apiKey        string
+	apiSecret     string
+	subaccountID  string
+	httpClient    *http.Client
+	httpTimeout   time.Duration
+	apiBase       string
+	wsScheme      string
+	wsHost        string
+	wsDialer      *websocket.Dialer
+	limiter       *RateLimiter
+	retry         *RetryPolicy
+	now           func() time.Time
+	userAgent     string
+	logger        Logger
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
+	metrics       Metrics
+	gapEvents     chan<- SequenceGap
+	debug         bool
+}
+
+// NewClientWithOptions returns a new Bittrex HTTP client configured by opts
+func NewClientWithOptions(opts ...Option) (c *Client, err error) {
+	c = &Client{apiBase: API_BASE, wsScheme: "https", wsHost: WS_BASE, wsDialer: websocket.DefaultDialer, now: time.Now, logger: stdLogger{}, metrics: NopMetrics{}}
+	for _, opt := range opts {
+		if err = opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{}
+		if c.httpTimeout <= 0 {
+			c.httpTimeout = 1 * time.Second
+		}
+	}
+	if c.httpTimeout <= 0 {
+		c.httpTimeout = c.httpClient.Timeout
+		if c.httpTimeout <= 0 {
+			c.httpTimeout = 30 * time.Second
+		}
+	}
+	return c, nil
+}
+
+// mustNewClient returns a new Bittrex HTTP client configured by opts, panicking if an option is invalid
+func mustNewClient(opts ...Option) *Client {
+	c, err := NewClientWithOptions(opts...)
+	if err != nil {
+		panic("bittrex: " + err.Error())
+	}
+	return c
 }
 
 // NewClient return a new Bittrex HTTP client
 func NewClient(apiKey, apiSecret string) (c *Client) {
-	return &Client{apiKey, apiSecret, &http.Client{}, 1 * time.Second, false}
+	return mustNewClient(WithCredentials(apiKey, apiSecret))
 }
 
-// NewClientWithCustomHTTPConfig returns a new Bittrex HTTP client using the predefined http client
+// NewClientWithCustomHTTPConfig returns a new Bittrex HTTP client using the predefined http client, it panics if httpClient is nil
 func NewClientWithCustomHTTPConfig(apiKey, apiSecret string, httpClient *http.Client) (c *Client) {
-	timeout := httpClient.Timeout
-	if timeout <= 0 {
-		timeout = 30 * time.Second
-	}
-	return &Client{apiKey, apiSecret, httpClient, timeout, false}
+	return mustNewClient(WithCredentials(apiKey, apiSecret), WithHTTPClient(httpClient))
 }
 
 // NewClientWithCustomTimeout returns a new Bittrex HTTP client with custom timeout
 func NewClientWithCustomTimeout(apiKey, apiSecret string, timeout time.Duration) (c *Client) {
-	return &Client{apiKey, apiSecret, &http.Client{}, timeout, false}
+	return mustNewClient(WithCredentials(apiKey, apiSecret), WithTimeout(timeout))
 }
 
-func (c Client) dumpRequest(r *http.Request) {
-	if r == nil {
-		log.Print("dumpReq ok: <nil>")
-		return
-	}
-	dump, err := httputil.DumpRequest(r, true)
-	if err != nil {
-		log.Print("dumpReq err:", err)
-	} else {
-		log.Print("dumpReq ok:", string(dump))
+// logDebug logs a debug message if debug is enabled
+func (c *Client) logDebug(msg string, args ...interface{}) {
+	if c.debug {
+		c.logger.Debug(msg, args...)
 	}
 }
 
-func (c Client) dumpResponse(r *http.Response) {
-	if r == nil {
-		log.Print("dumpResponse ok: <nil>")
+// do prepare and process HTTP request to HTTP API
+func (c *Client) do(ctx context.Context, method string, resource string, payload string, authNeeded bool) (response []byte, err error) {
+	response, _, err = c.doWithMeta(ctx, method, resource, payload, authNeeded)
+	return
+}
+
+// doWithMeta prepare and process HTTP request to HTTP API, returning the response metadata along with the body.
+//
+//	GET, HEAD and DELETE requests are retried according to the retry policy of the client.
+func (c *Client) doWithMeta(ctx context.Context, method string, resource string, payload string, authNeeded bool) (response []byte, meta ResponseMeta, err error) {
+	retryable := method == "GET" || method == "HEAD" || method == "DELETE"
+	return c.doRequest(ctx, method, resource, payload, authNeeded, retryable)
+}
+
+// doRequest prepare and process HTTP request to HTTP API, retrying it on failure if retryable is set.
+//
+//	Each attempt is bound to ctx, and cancelled if it is not completed within the client timeout.
+func (c *Client) doRequest(ctx context.Context, method string, resource string, payload string, authNeeded bool, retryable bool) (response []byte, meta ResponseMeta, err error) {
+	if authNeeded && (len(c.apiKey) == 0 || len(c.apiSecret) == 0) {
+		err = errors.New("you need to set API Key and API Secret to call this method")
 		return
 	}
-	dump, err := httputil.DumpResponse(r, true)
-	if err != nil {
-		fmt.Print("dumpResponse err:", err)
+
+	var rawurl string
+	if strings.HasPrefix(resource, "http") {
+		rawurl = resource
 	} else {
-		fmt.Print("dumpResponse ok:", string(dump))
+		rawurl = fmt.Sprintf("%s%s/%s", c.apiBase, API_VERSION, resource)
 	}
-}
 
-// doTimeoutRequest do a HTTP request with timeout
-func (c *Client) doTimeoutRequest(timer *time.Timer, req *http.Request) (*http.Response, error) {
-	// Do the request in the background so we can check the timeout
-	type result struct {
-		resp *http.Response
-		err  error
-	}
-	done := make(chan result, 1)
-	go func() {
-		if c.debug {
-			c.dumpRequest(req)
+	for attempt := 1; ; attempt++ {
+		response, meta, err = c.send(ctx, method, rawurl, payload, authNeeded, attempt)
+		meta.Attempts = attempt
+		if err == nil || !retryable || c.retry == nil || attempt >= c.retry.MaxAttempts || !c.retry.shouldRetry(ctx, err) {
+			return
+		}
+
+		c.metrics.IncRetries(method, endpointTemplate(rawurl))
+		delay := c.retry.backoff(attempt)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter() > delay {
+			delay = apiErr.RetryAfter()
 		}
-		resp, err := c.httpClient.Do(req)
-		if c.debug {
-			c.dumpResponse(resp)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
 		}
-		done <- result{resp, err}
-	}()
-	// Wait for the read or the timeout
-	select {
-	case r := <-done:
-		return r.resp, r.err
-	case <-timer.C:
-		return nil, errors.New("timeout on reading data from Bittrex API")
 	}
 }
 
-// do prepare and process HTTP request to HTTP API
-func (c *Client) do(method string, resource string, payload string, authNeeded bool) (response []byte, err error) {
-	connectTimer := time.NewTimer(c.httpTimeout)
-
-	var rawurl string
-	if strings.HasPrefix(resource, "http") {
-		rawurl = resource
-	} else {
-		rawurl = fmt.Sprintf("%s%s/%s", API_BASE, API_VERSION, resource)
+// send signs and sends a single HTTP request, calling the hooks around it
+func (c *Client) send(ctx context.Context, method string, rawurl string, payload string, authNeeded bool, attempt int) (response []byte, meta ResponseMeta, err error) {
+	// Time spent queued by the rate limiter does not count against the timeout
+	if c.limiter != nil {
+		var wait time.Duration
+		wait, err = c.limiter.Wait(ctx, authNeeded)
+		c.metrics.ObserveRateLimitWait(authNeeded, wait)
+		if err != nil {
+			return
+		}
 	}
 
-	req, err := http.NewRequest(method, rawurl, strings.NewReader(payload))
+	ctx, cancel := context.WithTimeout(ctx, c.httpTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, rawurl, strings.NewReader(payload))
 	if err != nil {
 		return
 	}
@@ -120,15 +177,13 @@ func (c *Client) do(method string, resource string, payload string, authNeeded b
 	}
 
 	req.Header.Add("Accept", "application/json")
+	if len(c.userAgent) > 0 {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 
-	// Auth
+	// Auth, signed with a fresh timestamp on every attempt
 	if authNeeded {
-		if len(c.apiKey) == 0 || len(c.apiSecret) == 0 {
-			err = errors.New("you need to set API Key and API Secret to call this method")
-			return
-		}
-
-		apiTimestamp := fmt.Sprintf("%d", time.Now().UnixNano()/1000000)
+		apiTimestamp := fmt.Sprintf("%d", c.now().UnixNano()/1000000)
 
 		sha512Bytes := sha512.Sum512([]byte(payload))
 		apiContentHash := hex.EncodeToString(sha512Bytes[:])
@@ -138,6 +193,10 @@ func (c *Client) do(method string, resource string, payload string, authNeeded b
 		req.Header.Add("Api-Content-Hash", apiContentHash)
 
 		preSign := strings.Join([]string{apiTimestamp, rawurl, method, apiContentHash}, "")
+		if len(c.subaccountID) > 0 {
+			req.Header.Add("Api-Subaccount-Id", c.subaccountID)
+			preSign += c.subaccountID
+		}
 
 		mac := hmac.New(sha512.New, []byte(c.apiSecret))
 		_, err = mac.Write([]byte(preSign))
@@ -145,24 +204,97 @@ func (c *Client) do(method string, resource string, payload string, authNeeded b
 		req.Header.Add("Api-Signature", sig)
 	}
 
-	resp, err := c.doTimeoutRequest(connectTimer, req)
-	if err != nil {
-		return
+	info := RequestInfo{Method: method, URL: rawurl, Endpoint: endpointTemplate(rawurl), Header: redactHeader(req.Header), Attempt: attempt}
+	for _, hook := range c.requestHooks {
+		hook.BeforeRequest(ctx, info)
 	}
+	c.logDebug("request", "method", method, "url", rawurl, "attempt", attempt, "header", info.Header, "payload", payload)
 
-	defer resp.Body.Close()
-	response, err = io.ReadAll(resp.Body)
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err == nil {
+		defer resp.Body.Close()
+		meta = ResponseMeta{StatusCode: resp.StatusCode, Header: resp.Header}
+		meta.Sequence, _ = parseSequence(resp.Header)
+		response, err = io.ReadAll(resp.Body)
+	}
+	result := ResponseInfo{RequestInfo: info, StatusCode: meta.StatusCode, Header: meta.Header, Duration: time.Since(start), Err: err}
+	defer func() {
+		result.Err = err
+		c.metrics.ObserveRequest(method, info.Endpoint, result.StatusCode, result.Duration)
+		for _, hook := range c.responseHooks {
+			hook.AfterResponse(ctx, result)
+		}
+	}()
 	if err != nil {
-		return nil, err
+		c.logDebug("response", "method", method, "url", rawurl, "attempt", attempt, "duration", result.Duration, "error", err)
+		return nil, meta, err
 	}
+	c.logDebug("response", "method", method, "url", rawurl, "attempt", attempt, "duration", result.Duration, "status", resp.StatusCode, "header", meta.Header, "body", string(response))
 
-	if resp.StatusCode != 201 && method == "POST" {
-		err = errors.New(resp.Status)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := newAPIError(req, resp, response)
+		if c.limiter != nil && resp.StatusCode == http.StatusTooManyRequests {
+			c.limiter.Backoff(authNeeded, apiErr.RetryAfter())
+		}
+		err = apiErr
+	} else if c.limiter != nil {
+		c.limiter.Succeeded(authNeeded)
 	}
 
-	if resp.StatusCode != 200 && (method == "GET" || method == "DELETE") {
-		err = errors.New(resp.Status)
+	return response, meta, err
+}
+
+// setAPIBase validates and set the base URL of the HTTP API
+func (c *Client) setAPIBase(apiBase string) error {
+	u, err := url.Parse(apiBase)
+	if err != nil {
+		return err
 	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("invalid API base URL %q", apiBase)
+	}
+	if !strings.HasSuffix(apiBase, "/") {
+		apiBase += "/"
+	}
+	c.apiBase = apiBase
+	return nil
+}
 
-	return response, err
+// setSocketBase validates and set the endpoint of the SignalR socket
+func (c *Client) setSocketBase(socketBase string) error {
+	u, err := url.Parse(socketBase)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "ws":
+		c.wsScheme = "http"
+	case "https", "wss":
+		c.wsScheme = "https"
+	default:
+		return fmt.Errorf("invalid socket base URL %q", socketBase)
+	}
+	if len(u.Host) == 0 {
+		return fmt.Errorf("invalid socket base URL %q", socketBase)
+	}
+	c.wsHost = u.Host
+	return nil
+}
+
+// ResponseMeta describes the response of the HTTP API a result was decoded from
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+	Sequence   int // Sequence header of snapshot endpoints, 0 when missing
+	Attempts   int // Number of requests sent, more than 1 when the request was retried
+}
+
+// parseSequence reads the Sequence header returned by snapshot endpoints
+func parseSequence(header http.Header) (sequence int, err error) {
+	value := header.Get("Sequence")
+	if len(value) == 0 {
+		return 0, errors.New("missing Sequence header")
+	}
+	return strconv.Atoi(value)
 }
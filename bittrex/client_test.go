@@ -0,0 +1,67 @@
+package bittrex
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sign computes the expected Api-Signature of a request received by a test server
+func sign(secret string, r *http.Request, rawurl string) string {
+	preSign := r.Header.Get("Api-Timestamp") + rawurl + r.Method + r.Header.Get("Api-Content-Hash") + r.Header.Get("Api-Subaccount-Id")
+	mac := hmac.New(sha512.New, []byte(secret))
+	_, _ = mac.Write([]byte(preSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestClient_DoSignsSubaccountRequests(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get("Api-Key"))
+		assert.Equal(t, "subaccount", r.Header.Get("Api-Subaccount-Id"))
+		assert.Equal(t, sign("secret", r, server.URL+r.URL.RequestURI()), r.Header.Get("Api-Signature"))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	bt := New("key", "secret").WithSubaccount("subaccount")
+	r, err := bt.client.do(context.Background(), "GET", server.URL+"/v3/balances?currencySymbol=BTC", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(r))
+}
+
+func TestClient_DoRequiresCredentials(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.client.do(context.Background(), "GET", "balances", "", true)
+	assert.Error(t, err)
+}
+
+func TestClient_DoWithContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bt := NewWithCustomTimeout("", "", time.Minute)
+	assert.NoError(t, bt.SetAPIBase(server.URL))
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err := bt.PingCtx(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	bt = NewWithCustomTimeout("", "", 50*time.Millisecond)
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,134 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matching the most common Bittrex error codes, to be used with errors.Is
+var (
+	ErrAPIKeyInvalid               = errors.New("APIKEY_INVALID")
+	ErrInvalidSignature            = errors.New("INVALID_SIGNATURE")
+	ErrNotFound                    = errors.New("NOT_FOUND")
+	ErrRateLimit                   = errors.New("RATE_LIMIT")
+	ErrMarketDoesNotExist          = errors.New("MARKET_DOES_NOT_EXIST")
+	ErrMarketOffline               = errors.New("MARKET_OFFLINE")
+	ErrCurrencyDoesNotExist        = errors.New("CURRENCY_DOES_NOT_EXIST")
+	ErrInsufficientFunds           = errors.New("INSUFFICIENT_FUNDS")
+	ErrMinTradeRequirementNotMet   = errors.New("MIN_TRADE_REQUIREMENT_NOT_MET")
+	ErrDustTradeDisallowedMinValue = errors.New("DUST_TRADE_DISALLOWED_MIN_VALUE")
+	ErrOrderNotOpen                = errors.New("ORDER_NOT_OPEN")
+	ErrDuplicateClientOrderID      = errors.New("DUPLICATE_CLIENT_ORDER_ID")
+)
+
+var codeErrors = map[string]error{}
+
+func init() {
+	for _, err := range []error{
+		ErrAPIKeyInvalid,
+		ErrInvalidSignature,
+		ErrNotFound,
+		ErrRateLimit,
+		ErrMarketDoesNotExist,
+		ErrMarketOffline,
+		ErrCurrencyDoesNotExist,
+		ErrInsufficientFunds,
+		ErrMinTradeRequirementNotMet,
+		ErrDustTradeDisallowedMinValue,
+		ErrOrderNotOpen,
+		ErrDuplicateClientOrderID,
+	} {
+		codeErrors[err.Error()] = err
+	}
+}
+
+// APIError is returned when the HTTP API answers with a non successful status.
+//
+//	Code, Detail and Data are read from the error body sent by Bittrex, when there is one.
+//	RateLimitHeaders holds the Retry-After and rate limit headers of the response.
+type APIError struct {
+	StatusCode       int
+	Status           string
+	Code             string
+	Detail           string
+	Data             interface{}
+	Method           string
+	URL              string
+	RateLimitHeaders http.Header
+}
+
+// newAPIError builds the error for an unsuccessful response from its status, headers and body
+func newAPIError(req *http.Request, resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode:       resp.StatusCode,
+		Status:           resp.Status,
+		Method:           req.Method,
+		URL:              req.URL.String(),
+		RateLimitHeaders: http.Header{},
+	}
+
+	var detail ErrorDetail
+	if json.Unmarshal(body, &detail) == nil {
+		e.Code = detail.Code
+		e.Detail = detail.Detail
+		e.Data = detail.Data
+	}
+
+	for key, values := range resp.Header {
+		if key == "Retry-After" || strings.Contains(strings.ToLower(key), "ratelimit") {
+			e.RateLimitHeaders[key] = values
+		}
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	msg := e.Status
+	if len(msg) == 0 {
+		msg = strconv.Itoa(e.StatusCode)
+	}
+	if len(e.Code) > 0 {
+		msg += ": " + e.Code
+	}
+	if len(e.Detail) > 0 {
+		msg += " (" + e.Detail + ")"
+	}
+	if len(e.Method) > 0 {
+		return e.Method + " " + e.URL + ": " + msg
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors, by Bittrex code or HTTP status
+func (e *APIError) Is(target error) bool {
+	if err, ok := codeErrors[e.Code]; ok && err == target {
+		return true
+	}
+	switch target {
+	case ErrRateLimit:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound && len(e.Code) == 0
+	}
+	return false
+}
+
+// RetryAfter returns the delay requested by the Retry-After header, or 0 if there is none
+func (e *APIError) RetryAfter() time.Duration {
+	value := e.RateLimitHeaders.Get("Retry-After")
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
@@ -0,0 +1,51 @@
+package bittrex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_FromResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/orders":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code":"INSUFFICIENT_FUNDS","detail":"not enough BTC","data":{"available":"0"}}`))
+		case "/v3/markets/XXX-USD":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"MARKET_DOES_NOT_EXIST"}`))
+		default:
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	bt := New("key", "secret")
+	_, err := bt.client.do(context.Background(), "POST", server.URL+"/v3/orders", "{}", true)
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	assert.Equal(t, "INSUFFICIENT_FUNDS", apiErr.Code)
+	assert.Equal(t, "not enough BTC", apiErr.Detail)
+	assert.Equal(t, "POST", apiErr.Method)
+	assert.Equal(t, server.URL+"/v3/orders", apiErr.URL)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.NotErrorIs(t, err, ErrRateLimit)
+	assert.EqualError(t, err, "POST "+server.URL+"/v3/orders: 409 Conflict: INSUFFICIENT_FUNDS (not enough BTC)")
+
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/markets/XXX-USD", "", false)
+	assert.ErrorIs(t, err, ErrMarketDoesNotExist)
+	assert.NotErrorIs(t, err, ErrNotFound)
+
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.ErrorIs(t, err, ErrRateLimit)
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 2*time.Second, apiErr.RetryAfter())
+}
@@ -0,0 +1,172 @@
+package bittrex
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RequestInfo describes a request to the HTTP API
+type RequestInfo struct {
+	Method   string
+	URL      string
+	Endpoint string      // Endpoint template, e.g. "orders/{orderId}", or "unknown"
+	Header   http.Header // Request headers, credentials redacted
+	Attempt  int         // Attempt number, starting at 1
+}
+
+// ResponseInfo describes the outcome of a request to the HTTP API
+type ResponseInfo struct {
+	RequestInfo
+	StatusCode int           // 0 if no response was received
+	Header     http.Header   // Response headers
+	Duration   time.Duration // Time taken by the round-trip, queuing in the rate limiter excluded
+	Err        error
+}
+
+// RequestHook is called before each request attempt is sent
+type RequestHook interface {
+	BeforeRequest(ctx context.Context, info RequestInfo)
+}
+
+// ResponseHook is called after each request attempt, whether it succeeded or not
+type ResponseHook interface {
+	AfterResponse(ctx context.Context, info ResponseInfo)
+}
+
+// RequestHookFunc adapts a function to the RequestHook interface
+type RequestHookFunc func(ctx context.Context, info RequestInfo)
+
+func (f RequestHookFunc) BeforeRequest(ctx context.Context, info RequestInfo) {
+	f(ctx, info)
+}
+
+// ResponseHookFunc adapts a function to the ResponseHook interface
+type ResponseHookFunc func(ctx context.Context, info ResponseInfo)
+
+func (f ResponseHookFunc) AfterResponse(ctx context.Context, info ResponseInfo) {
+	f(ctx, info)
+}
+
+// redactedHeaders are the request headers whose value is never exposed
+var redactedHeaders = []string{"Api-Key", "Api-Signature"}
+
+// redactHeader returns a copy of header with the credentials redacted
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range redactedHeaders {
+		if len(redacted.Values(name)) > 0 {
+			redacted.Set(name, "REDACTED")
+		}
+	}
+	return redacted
+}
+
+// endpoints are the templates of the HTTP API endpoints
+var endpoints = []string{
+	"account",
+	"account/fees/fiat",
+	"account/fees/trading",
+	"account/fees/trading/{marketSymbol}",
+	"account/permissions/currencies",
+	"account/permissions/currencies/{currencySymbol}",
+	"account/permissions/markets",
+	"account/permissions/markets/{marketSymbol}",
+	"account/volume",
+	"addresses",
+	"addresses/{currencySymbol}",
+	"balances",
+	"balances/{currencySymbol}",
+	"batch",
+	"conditional-orders",
+	"conditional-orders/closed",
+	"conditional-orders/open",
+	"conditional-orders/{conditionalOrderId}",
+	"currencies",
+	"currencies/{symbol}",
+	"deposits/ByTxId/{txId}",
+	"deposits/closed",
+	"deposits/open",
+	"deposits/{depositId}",
+	"executions",
+	"executions/last-id",
+	"executions/{executionId}",
+	"markets",
+	"markets/summaries",
+	"markets/tickers",
+	"markets/{marketSymbol}",
+	"markets/{marketSymbol}/candles/{candleInterval}/historical/{year}",
+	"markets/{marketSymbol}/candles/{candleInterval}/historical/{year}/{month}",
+	"markets/{marketSymbol}/candles/{candleInterval}/historical/{year}/{month}/{day}",
+	"markets/{marketSymbol}/candles/{candleInterval}/recent",
+	"markets/{marketSymbol}/candles/{candleType}/{candleInterval}/historical/{year}",
+	"markets/{marketSymbol}/candles/{candleType}/{candleInterval}/historical/{year}/{month}",
+	"markets/{marketSymbol}/candles/{candleType}/{candleInterval}/historical/{year}/{month}/{day}",
+	"markets/{marketSymbol}/candles/{candleType}/{candleInterval}/recent",
+	"markets/{marketSymbol}/orderbook",
+	"markets/{marketSymbol}/summary",
+	"markets/{marketSymbol}/ticker",
+	"markets/{marketSymbol}/trades",
+	"orders",
+	"orders/closed",
+	"orders/open",
+	"orders/{orderId}",
+	"orders/{orderId}/executions",
+	"ping",
+	"subaccounts",
+	"subaccounts/{subaccountId}",
+	"transfers",
+	"transfers/received",
+	"transfers/sent",
+	"transfers/{transferId}",
+	"withdrawals",
+	"withdrawals/ByTxId/{txId}",
+	"withdrawals/allowed-addresses",
+	"withdrawals/closed",
+	"withdrawals/open",
+	"withdrawals/{withdrawalId}",
+}
+
+// unknownEndpoint is the template of the requests matching no endpoint
+const unknownEndpoint = "unknown"
+
+// endpointTemplate returns the template of the endpoint requested by rawurl, e.g. "orders/{orderId}".
+//
+//	Among the matching templates the one with the most literal segments wins, so "orders/open" is not taken for an order ID.
+//	unknownEndpoint is returned if no template matches, so that metrics labelled by endpoint keep a bounded cardinality.
+func endpointTemplate(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return unknownEndpoint
+	}
+	path := strings.Trim(u.Path, "/")
+	if i := strings.Index(path, API_VERSION+"/"); i >= 0 {
+		path = path[i+len(API_VERSION)+1:]
+	}
+	segments := strings.Split(path, "/")
+
+	best, bestScore := unknownEndpoint, -1
+	for _, endpoint := range endpoints {
+		parts := strings.Split(endpoint, "/")
+		if len(parts) != len(segments) {
+			continue
+		}
+		score := 0
+		for i, part := range parts {
+			if strings.HasPrefix(part, "{") {
+				continue
+			}
+			if part != segments[i] {
+				score = -1
+				break
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = endpoint, score
+		}
+	}
+	return best
+}
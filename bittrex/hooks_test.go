@@ -0,0 +1,84 @@
+package bittrex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks_EndpointTemplate(t *testing.T) {
+	for rawurl, endpoint := range map[string]string{
+		"https://api.bittrex.com/v3/orders/open?marketSymbol=ETH-USD":                    "orders/open",
+		"https://api.bittrex.com/v3/orders/42":                                           "orders/{orderId}",
+		"https://api.bittrex.com/v3/orders/42/executions":                                "orders/{orderId}/executions",
+		"https://api.bittrex.com/v3/markets/ETH-USD/orderbook?depth=25":                  "markets/{marketSymbol}/orderbook",
+		"https://api.bittrex.com/v3/markets/summaries":                                   "markets/summaries",
+		"https://api.bittrex.com/v3/markets/ETH-USD/candles/TRADE/DAY_1/historical/2021": "markets/{marketSymbol}/candles/{candleType}/{candleInterval}/historical/{year}",
+		"https://api.bittrex.com/v3/markets/ETH-USD/candles/HOUR_1/historical/2021/1":    "markets/{marketSymbol}/candles/{candleInterval}/historical/{year}/{month}",
+		"http://localhost:8080/v3/orders/42/unknown":                                     "unknown",
+		"http://localhost:8080/v3/unknown/path":                                          "unknown",
+	} {
+		assert.Equal(t, endpoint, endpointTemplate(rawurl), rawurl)
+	}
+}
+
+func TestHooks_RedactHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Api-Key", "key")
+	header.Set("Api-Signature", "signature")
+	header.Set("Api-Timestamp", "1633046400000")
+	redacted := redactHeader(header)
+	assert.Equal(t, "REDACTED", redacted.Get("Api-Key"))
+	assert.Equal(t, "REDACTED", redacted.Get("Api-Signature"))
+	assert.Equal(t, "1633046400000", redacted.Get("Api-Timestamp"))
+	assert.Equal(t, "key", header.Get("Api-Key"))
+}
+
+func TestHooks_BeforeAndAfterEachAttempt(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var requests []RequestInfo
+	var responses []ResponseInfo
+	bt, err := NewWithOptions(
+		WithCredentials("key", "secret"),
+		WithAPIBase(server.URL),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		WithRequestHook(RequestHookFunc(func(ctx context.Context, info RequestInfo) {
+			requests = append(requests, info)
+		})),
+	)
+	assert.NoError(t, err)
+	bt.AddResponseHook(ResponseHookFunc(func(ctx context.Context, info ResponseInfo) {
+		responses = append(responses, info)
+	}))
+
+	_, err = bt.GetOrder("42")
+	assert.NoError(t, err)
+	assert.Len(t, requests, 2)
+	assert.Len(t, responses, 2)
+	assert.Equal(t, "orders/{orderId}", requests[0].Endpoint)
+	assert.Equal(t, "GET", requests[0].Method)
+	assert.Equal(t, "REDACTED", requests[0].Header.Get("Api-Key"))
+	assert.Equal(t, "REDACTED", requests[0].Header.Get("Api-Signature"))
+	assert.True(t, strings.HasSuffix(requests[1].URL, "/v3/orders/42"))
+	assert.Equal(t, 2, requests[1].Attempt)
+	assert.Equal(t, http.StatusServiceUnavailable, responses[0].StatusCode)
+	assert.Error(t, responses[0].Err)
+	assert.Equal(t, http.StatusOK, responses[1].StatusCode)
+	assert.NoError(t, responses[1].Err)
+	assert.Equal(t, 2, responses[1].Attempt)
+}
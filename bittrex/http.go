@@ -2,13 +2,18 @@
 package bittrex
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 )
 
 const (
@@ -19,6 +24,50 @@ const (
 	INTERVAL_HOUR1   = "HOUR_1"
 	INTERVAL_MINUTE5 = "MINUTE_5"
 	INTERVAL_MINUTE1 = "MINUTE_1"
+
+	ORDERDIRECTION_BUY  = "BUY"
+	ORDERDIRECTION_SELL = "SELL"
+
+	ORDERTYPE_LIMIT         = "LIMIT"
+	ORDERTYPE_MARKET        = "MARKET"
+	ORDERTYPE_CEILINGLIMIT  = "CEILING_LIMIT"
+	ORDERTYPE_CEILINGMARKET = "CEILING_MARKET"
+
+	TIMEINFORCE_GOODTILCANCELLED         = "GOOD_TIL_CANCELLED"
+	TIMEINFORCE_IMMEDIATEORCANCEL        = "IMMEDIATE_OR_CANCEL"
+	TIMEINFORCE_FILLORKILL               = "FILL_OR_KILL"
+	TIMEINFORCE_POSTONLYGOODTILCANCELLED = "POST_ONLY_GOOD_TIL_CANCELLED"
+	TIMEINFORCE_BUYNOW                   = "BUY_NOW"
+	TIMEINFORCE_INSTANT                  = "INSTANT"
+
+	ORDERSTATUS_OPEN   = "OPEN"
+	ORDERSTATUS_CLOSED = "CLOSED"
+
+	ORDERTOCANCELTYPE_ORDER            = "ORDER"
+	ORDERTOCANCELTYPE_CONDITIONALORDER = "CONDITIONAL_ORDER"
+
+	CONDITIONALOPERATION_LTE = "LTE"
+	CONDITIONALOPERATION_GTE = "GTE"
+
+	CONDITIONALORDERSTATUS_OPEN      = "OPEN"
+	CONDITIONALORDERSTATUS_COMPLETED = "COMPLETED"
+	CONDITIONALORDERSTATUS_CANCELLED = "CANCELLED"
+	CONDITIONALORDERSTATUS_FAILED    = "FAILED"
+
+	DEPOSITSTATUS_PENDING     = "PENDING"
+	DEPOSITSTATUS_COMPLETED   = "COMPLETED"
+	DEPOSITSTATUS_ORPHANED    = "ORPHANED"
+	DEPOSITSTATUS_INVALIDATED = "INVALIDATED"
+
+	WITHDRAWALSTATUS_REQUESTED           = "REQUESTED"
+	WITHDRAWALSTATUS_AUTHORIZED          = "AUTHORIZED"
+	WITHDRAWALSTATUS_PENDING             = "PENDING"
+	WITHDRAWALSTATUS_ERRORINVALIDADDRESS = "ERROR_INVALID_ADDRESS"
+	WITHDRAWALSTATUS_COMPLETED           = "COMPLETED"
+	WITHDRAWALSTATUS_CANCELLED           = "CANCELLED"
+
+	ADDRESSSTATUS_REQUESTED   = "REQUESTED"
+	ADDRESSSTATUS_PROVISIONED = "PROVISIONED"
 )
 
 type Bittrex struct {
@@ -28,31 +77,172 @@ type Bittrex struct {
 // New returns an instantiated bittrex struct
 func New(apiKey, apiSecret string) *Bittrex {
 	client := NewClient(apiKey, apiSecret)
-	return &Bittrex{client}
+	return &Bittrex{client: client}
+}
+
+// NewWithOptions returns an instantiated bittrex struct configured by opts, e.g.
+//
+//	bittrex.NewWithOptions(bittrex.WithCredentials(apiKey, apiSecret), bittrex.WithRetryPolicy(&bittrex.DefaultRetryPolicy))
+//
+//	It is the constructor to configure a client with, New keeps its signature for existing callers,
+//	and New and the NewWith* constructors are thin wrappers around it.
+func NewWithOptions(opts ...Option) (*Bittrex, error) {
+	client, err := NewClientWithOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Bittrex{client: client}, nil
 }
 
-// NewWithCustomHTTPClient returns an instantiated bittrex struct with custom http client
+// NewWithCustomHTTPClient returns an instantiated bittrex struct with custom http client, it panics if httpClient is nil
 func NewWithCustomHTTPClient(apiKey, apiSecret string, httpClient *http.Client) *Bittrex {
 	client := NewClientWithCustomHTTPConfig(apiKey, apiSecret, httpClient)
-	return &Bittrex{client}
+	return &Bittrex{client: client}
 }
 
 // NewWithCustomTimeout returns an instantiated bittrex struct with custom timeout
 func NewWithCustomTimeout(apiKey, apiSecret string, timeout time.Duration) *Bittrex {
 	client := NewClientWithCustomTimeout(apiKey, apiSecret, timeout)
-	return &Bittrex{client}
+	return &Bittrex{client: client}
 }
 
-// SetDebug set enable/disable http request/response dump
+// SetDebug set enable/disable debug messages, including a log of each http request/response with the credentials redacted
 func (b *Bittrex) SetDebug(enable bool) {
 	b.client.debug = enable
 }
 
+// SetMetrics set the collector of the metrics of the library (nil to discard them)
+func (b *Bittrex) SetMetrics(metrics Metrics) {
+	if metrics == nil {
+		metrics = NopMetrics{}
+	}
+	b.client.metrics = metrics
+}
+
+// SetGapEvents set the channel on which the Subscribe*Updates functions report sequence gaps, events are dropped if it is full.
+//
+//	Stream clients created afterwards report their gaps on it too, unless changed with StreamClient.SetGapEvents.
+func (b *Bittrex) SetGapEvents(events chan<- SequenceGap) {
+	b.client.gapEvents = events
+}
+
+// AddRequestHook registers a hook called before each http request attempt
+func (b *Bittrex) AddRequestHook(hook RequestHook) {
+	b.client.requestHooks = append(b.client.requestHooks[:len(b.client.requestHooks):len(b.client.requestHooks)], hook)
+}
+
+// AddResponseHook registers a hook called after each http request attempt
+func (b *Bittrex) AddResponseHook(hook ResponseHook) {
+	b.client.responseHooks = append(b.client.responseHooks[:len(b.client.responseHooks):len(b.client.responseHooks)], hook)
+}
+
+// SetLogger set the logger receiving the diagnostics of the library (nil to discard them)
+func (b *Bittrex) SetLogger(logger Logger) {
+	if logger == nil {
+		logger = nopLogger{}
+	}
+	b.client.logger = logger
+}
+
+// SetAPIBase set the base URL of the HTTP API, API_BASE by default.
+//
+//	Plain http URLs are accepted, to point the client at a local server or at a proxy.
+func (b *Bittrex) SetAPIBase(apiBase string) error {
+	return b.client.setAPIBase(apiBase)
+}
+
+// SetSocketBase set the endpoint of the websocket API, "https://socket-v3.bittrex.com" by default.
+//
+//	With an http or ws URL the SignalR connection is negotiated over http and the socket dialed over ws,
+//	with an https or wss URL over https and wss.
+func (b *Bittrex) SetSocketBase(socketBase string) error {
+	return b.client.setSocketBase(socketBase)
+}
+
+// SetWebsocketDialer set the dialer of the websocket connections, websocket.DefaultDialer by default.
+//
+//	The SignalR connections are negotiated with the http client, and the sockets dialed with this dialer,
+//	set their proxy and TLS configuration to go through a proxy or to trust a local server.
+func (b *Bittrex) SetWebsocketDialer(dialer *websocket.Dialer) error {
+	if dialer == nil {
+		return errors.New("invalid websocket dialer pointer")
+	}
+	b.client.wsDialer = dialer
+	return nil
+}
+
+// SetRateLimiter set the rate limiter queuing requests to stay within the API quotas (nil to disable it)
+func (b *Bittrex) SetRateLimiter(limiter *RateLimiter) {
+	b.client.limiter = limiter
+}
+
+// SetRetryPolicy set the policy used to retry failed idempotent requests (nil to disable retries)
+func (b *Bittrex) SetRetryPolicy(policy *RetryPolicy) {
+	b.client.retry = policy
+}
+
+// SetSubaccountID set the subaccount on behalf of which authenticated requests are made (empty for the master account)
+func (b *Bittrex) SetSubaccountID(subaccountID string) {
+	b.client.subaccountID = subaccountID
+}
+
+// WithSubaccount returns a copy of the bittrex struct making authenticated requests on behalf of a subaccount
+func (b *Bittrex) WithSubaccount(subaccountID string) *Bittrex {
+	client := *b.client
+	client.subaccountID = subaccountID
+	b2 := *b
+	b2.client = &client
+	return &b2
+}
+
+// PageOpts holds the pagination and date range parameters shared by the listing endpoints.
+//
+//	NextPageToken and PreviousPageToken are the ID of the last (or first) item of the page already retrieved.
+//	StartDate and EndDate are only sent when set.
+type PageOpts struct {
+	NextPageToken     string
+	PreviousPageToken string
+	PageSize          int
+	StartDate         time.Time
+	EndDate           time.Time
+}
+
+func (o PageOpts) encode(q url.Values) {
+	if len(o.NextPageToken) > 0 {
+		q.Set("nextPageToken", o.NextPageToken)
+	}
+	if len(o.PreviousPageToken) > 0 {
+		q.Set("previousPageToken", o.PreviousPageToken)
+	}
+	if o.PageSize != 0 {
+		q.Set("pageSize", strconv.Itoa(o.PageSize))
+	}
+	if !o.StartDate.IsZero() {
+		q.Set("startDate", o.StartDate.UTC().Format(time.RFC3339))
+	}
+	if !o.EndDate.IsZero() {
+		q.Set("endDate", o.EndDate.UTC().Format(time.RFC3339))
+	}
+}
+
+// withQuery appends the encoded query to endpoint, if any
+func withQuery(endpoint string, q url.Values) string {
+	if len(q) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + q.Encode()
+}
+
 // Currencies
 
 // List currencies.
 func (b *Bittrex) GetCurrencies() (currencies []Currency, err error) {
-	r, err := b.client.do("GET", "currencies", "", false)
+	return b.GetCurrenciesCtx(context.Background())
+}
+
+// GetCurrenciesCtx is GetCurrencies with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCurrenciesCtx(ctx context.Context) (currencies []Currency, err error) {
+	r, err := b.client.do(ctx, "GET", "currencies", "", false)
 	if err != nil {
 		return
 	}
@@ -63,7 +253,12 @@ func (b *Bittrex) GetCurrencies() (currencies []Currency, err error) {
 
 // Retrieve info on a specified currency.
 func (b *Bittrex) GetCurrency(symbol string) (currency Currency, err error) {
-	r, err := b.client.do("GET", "currencies/"+symbol, "", false)
+	return b.GetCurrencyCtx(context.Background(), symbol)
+}
+
+// GetCurrencyCtx is GetCurrency with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCurrencyCtx(ctx context.Context, symbol string) (currency Currency, err error) {
+	r, err := b.client.do(ctx, "GET", "currencies/"+symbol, "", false)
 	if err != nil {
 		return
 	}
@@ -76,7 +271,12 @@ func (b *Bittrex) GetCurrency(symbol string) (currency Currency, err error) {
 
 // List markets.
 func (b *Bittrex) GetMarkets() (markets []Market, err error) {
-	r, err := b.client.do("GET", "markets", "", false)
+	return b.GetMarketsCtx(context.Background())
+}
+
+// GetMarketsCtx is GetMarkets with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketsCtx(ctx context.Context) (markets []Market, err error) {
+	r, err := b.client.do(ctx, "GET", "markets", "", false)
 	if err != nil {
 		return
 	}
@@ -87,29 +287,66 @@ func (b *Bittrex) GetMarkets() (markets []Market, err error) {
 
 // List summaries of the last 24 hours of activity for all markets.
 func (b *Bittrex) GetMarketsSummaries() (marketSummaries []MarketSummary, err error) {
-	r, err := b.client.do("GET", "markets/summaries", "", false)
+	marketSummaries, _, err = b.GetMarketsSummariesWithMeta()
+	return
+}
+
+// List summaries of the last 24 hours of activity for all markets.
+//
+//	The response metadata is returned along with them, its Sequence (also set on the results) joins the snapshot with the market summaries stream.
+func (b *Bittrex) GetMarketsSummariesWithMeta() (marketSummaries []MarketSummary, meta ResponseMeta, err error) {
+	return b.GetMarketsSummariesWithMetaCtx(context.Background())
+}
+
+// GetMarketsSummariesWithMetaCtx is GetMarketsSummariesWithMeta with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketsSummariesWithMetaCtx(ctx context.Context) (marketSummaries []MarketSummary, meta ResponseMeta, err error) {
+	r, meta, err := b.client.doWithMeta(ctx, "GET", "markets/summaries", "", false)
 	if err != nil {
 		return
 	}
 
 	err = json.Unmarshal(r, &marketSummaries)
+	for i := range marketSummaries {
+		marketSummaries[i].Sequence = meta.Sequence
+	}
 	return
 }
 
 // List tickers for all markets.
 func (b *Bittrex) GetMarketsTickers() (marketTickers []Ticker, err error) {
-	r, err := b.client.do("GET", "markets/tickers", "", false)
+	marketTickers, _, err = b.GetMarketsTickersWithMeta()
+	return
+}
+
+// List tickers for all markets.
+//
+//	The response metadata is returned along with them, its Sequence (also set on the results) joins the snapshot with the tickers stream.
+func (b *Bittrex) GetMarketsTickersWithMeta() (marketTickers []Ticker, meta ResponseMeta, err error) {
+	return b.GetMarketsTickersWithMetaCtx(context.Background())
+}
+
+// GetMarketsTickersWithMetaCtx is GetMarketsTickersWithMeta with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketsTickersWithMetaCtx(ctx context.Context) (marketTickers []Ticker, meta ResponseMeta, err error) {
+	r, meta, err := b.client.doWithMeta(ctx, "GET", "markets/tickers", "", false)
 	if err != nil {
 		return
 	}
 
 	err = json.Unmarshal(r, &marketTickers)
+	for i := range marketTickers {
+		marketTickers[i].Sequence = meta.Sequence
+	}
 	return
 }
 
 // Retrieve the ticker for a specific market.
 func (b *Bittrex) GetTicker(marketSymbol string) (ticker Ticker, err error) {
-	r, err := b.client.do("GET", "markets/"+strings.ToUpper(marketSymbol)+"/ticker", "", false)
+	return b.GetTickerCtx(context.Background(), marketSymbol)
+}
+
+// GetTickerCtx is GetTicker with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetTickerCtx(ctx context.Context, marketSymbol string) (ticker Ticker, err error) {
+	r, err := b.client.do(ctx, "GET", "markets/"+strings.ToUpper(marketSymbol)+"/ticker", "", false)
 	if err != nil {
 		return
 	}
@@ -120,7 +357,12 @@ func (b *Bittrex) GetTicker(marketSymbol string) (ticker Ticker, err error) {
 
 // Retrieve the ticker for a specific market.
 func (b *Bittrex) GetMarket(marketSymbol string) (market Market, err error) {
-	r, err := b.client.do("GET", "markets/"+strings.ToUpper(marketSymbol), "", false)
+	return b.GetMarketCtx(context.Background(), marketSymbol)
+}
+
+// GetMarketCtx is GetMarket with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketCtx(ctx context.Context, marketSymbol string) (market Market, err error) {
+	r, err := b.client.do(ctx, "GET", "markets/"+strings.ToUpper(marketSymbol), "", false)
 	if err != nil {
 		return
 	}
@@ -131,7 +373,12 @@ func (b *Bittrex) GetMarket(marketSymbol string) (market Market, err error) {
 
 // Retrieve summary of the last 24 hours of activity for a specific market.
 func (b *Bittrex) GetSummary(marketSymbol string) (marketSummary MarketSummary, err error) {
-	r, err := b.client.do("GET", "markets/"+strings.ToUpper(marketSymbol)+"/summary", "", false)
+	return b.GetSummaryCtx(context.Background(), marketSymbol)
+}
+
+// GetSummaryCtx is GetSummary with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetSummaryCtx(ctx context.Context, marketSymbol string) (marketSummary MarketSummary, err error) {
+	r, err := b.client.do(ctx, "GET", "markets/"+strings.ToUpper(marketSymbol)+"/summary", "", false)
 	if err != nil {
 		return
 	}
@@ -151,33 +398,52 @@ func (b *Bittrex) GetOrderBook(marketSymbol string) (orderBook OrderBook, err er
 
 // Retrieve the order book for a specific market.
 func (b *Bittrex) GetOrderBookWithOpts(marketSymbol string, opts *GetOrderBookOpts) (orderBook OrderBook, err error) {
+	orderBook, _, err = b.GetOrderBookWithMeta(marketSymbol, opts)
+	return
+}
+
+// Retrieve the order book for a specific market.
+//
+//	The response metadata is returned along with it, its Sequence (also set on the results) joins the snapshot with the order book stream.
+func (b *Bittrex) GetOrderBookWithMeta(marketSymbol string, opts *GetOrderBookOpts) (orderBook OrderBook, meta ResponseMeta, err error) {
+	return b.GetOrderBookWithMetaCtx(context.Background(), marketSymbol, opts)
+}
+
+// GetOrderBookWithMetaCtx is GetOrderBookWithMeta with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOrderBookWithMetaCtx(ctx context.Context, marketSymbol string, opts *GetOrderBookOpts) (orderBook OrderBook, meta ResponseMeta, err error) {
 	v := reflect.ValueOf(opts)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
-		return orderBook, errors.New("invalid opts pointer")
+		return orderBook, meta, errors.New("invalid opts pointer")
 	}
 
 	endpoint := "markets/" + strings.ToUpper(marketSymbol) + "/orderbook?depth=25"
 	if !reflect.DeepEqual(opts, &GetOrderBookOpts{}) {
 		if v.Elem().Field(0).Interface().(int) != 0 {
 			if opts.Depth != 1 && opts.Depth != 25 && opts.Depth != 500 && opts.Depth != 0 {
-				return orderBook, errors.New("invalid depth")
+				return orderBook, meta, errors.New("invalid depth")
 			}
 			endpoint = "markets/" + strings.ToUpper(marketSymbol) + "/orderbook?depth=" + strconv.Itoa(opts.Depth)
 		}
 	}
 
-	r, err := b.client.do("GET", endpoint, "", false)
+	r, meta, err := b.client.doWithMeta(ctx, "GET", endpoint, "", false)
 	if err != nil {
 		return
 	}
 
 	err = json.Unmarshal(r, &orderBook)
+	orderBook.Sequence = meta.Sequence
 	return
 }
 
 // Retrieve the recent trades for a specific market.
 func (b *Bittrex) GetTrades(marketSymbol string) (trades []Trade, err error) {
-	r, err := b.client.do("GET", "markets/"+strings.ToUpper(marketSymbol)+"/trades", "", false)
+	return b.GetTradesCtx(context.Background(), marketSymbol)
+}
+
+// GetTradesCtx is GetTrades with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetTradesCtx(ctx context.Context, marketSymbol string) (trades []Trade, err error) {
+	r, err := b.client.do(ctx, "GET", "markets/"+strings.ToUpper(marketSymbol)+"/trades", "", false)
 	if err != nil {
 		return
 	}
@@ -203,6 +469,11 @@ func (b *Bittrex) GetCandles(marketSymbol string, candleInterval string) (candle
 //   (MINUTE_1: 1 day, MINUTE_5: 1 day, HOUR_1: 31 days, DAY_1: 366 days).
 //   Candles for intervals without any trading activity will match the previous close and volume will be zero.
 func (b *Bittrex) GetCandlesWithOpts(marketSymbol string, candleInterval string, opts *GetCandlesOpts) (candles []Candle, err error) {
+	return b.GetCandlesWithOptsCtx(context.Background(), marketSymbol, candleInterval, opts)
+}
+
+// GetCandlesWithOptsCtx is GetCandlesWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCandlesWithOptsCtx(ctx context.Context, marketSymbol string, candleInterval string, opts *GetCandlesOpts) (candles []Candle, err error) {
 	v := reflect.ValueOf(opts)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
 		return candles, errors.New("invalid opts pointer")
@@ -215,7 +486,7 @@ func (b *Bittrex) GetCandlesWithOpts(marketSymbol string, candleInterval string,
 		}
 	}
 
-	r, err := b.client.do("GET", endpoint, "", false)
+	r, err := b.client.do(ctx, "GET", endpoint, "", false)
 	if err != nil {
 		return
 	}
@@ -243,6 +514,11 @@ func (b *Bittrex) GetCandlesHistory(marketSymbol string, candleInterval string,
 //   (MINUTE_1: 1 day, MINUTE_5: 1 day, HOUR_1: 31 days, DAY_1: 366 days).
 //   Candles for intervals without any trading activity will match the previous close and volume will be zero.
 func (b *Bittrex) GetCandlesHistoryWithOpts(marketSymbol string, candleInterval string, year int, opts *GetCandlesHistoryOpts) (candles []Candle, err error) {
+	return b.GetCandlesHistoryWithOptsCtx(context.Background(), marketSymbol, candleInterval, year, opts)
+}
+
+// GetCandlesHistoryWithOptsCtx is GetCandlesHistoryWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCandlesHistoryWithOptsCtx(ctx context.Context, marketSymbol string, candleInterval string, year int, opts *GetCandlesHistoryOpts) (candles []Candle, err error) {
 	v := reflect.ValueOf(opts)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
 		return candles, errors.New("invalid opts pointer")
@@ -275,7 +551,7 @@ func (b *Bittrex) GetCandlesHistoryWithOpts(marketSymbol string, candleInterval
 		}
 	}
 
-	r, err := b.client.do("GET", endpoint, "", false)
+	r, err := b.client.do(ctx, "GET", endpoint, "", false)
 	if err != nil {
 		return
 	}
@@ -284,11 +560,1372 @@ func (b *Bittrex) GetCandlesHistoryWithOpts(marketSymbol string, candleInterval
 	return
 }
 
-// Ping
+// Orders
 
-// Pings the service
-func (b *Bittrex) Ping() (serverTime int64, err error) {
-	r, err := b.client.do("GET", "ping", "", false)
+// Create a new order.
+//
+//	The request is retried according to the retry policy only if the order has a ClientOrderID.
+//	When a retry fails with ErrDuplicateClientOrderID, the order was created by a previous attempt whose response was lost:
+//	it is looked up among the open and latest closed orders of the market and returned, or the error is returned if it is not found.
+//	The error is always returned when the first attempt fails with it.
+func (b *Bittrex) CreateOrder(newOrder NewOrder) (order PlacedOrder, err error) {
+	return b.CreateOrderCtx(context.Background(), newOrder)
+}
+
+// CreateOrderCtx is CreateOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateOrderCtx(ctx context.Context, newOrder NewOrder) (order PlacedOrder, err error) {
+	payload, err := json.Marshal(newOrder)
+	if err != nil {
+		return
+	}
+
+	// Creating an order twice is prevented by its client order id, so only then is it safe to retry
+	r, meta, err := b.client.doRequest(ctx, "POST", "orders", string(payload), true, len(newOrder.ClientOrderID) > 0)
+	if err != nil {
+		if meta.Attempts > 1 && errors.Is(err, ErrDuplicateClientOrderID) {
+			if existing, ok := b.findOrder(ctx, newOrder.MarketSymbol, newOrder.ClientOrderID); ok {
+				return existing, nil
+			}
+		}
+		return
+	}
+
+	err = json.Unmarshal(r, &order)
+	return
+}
+
+// findOrder looks up an order by its client order id among the open and latest closed orders of a market
+func (b *Bittrex) findOrder(ctx context.Context, marketSymbol string, clientOrderID string) (order PlacedOrder, ok bool) {
+	find := func(orders []PlacedOrder, err error) bool {
+		for _, o := range orders {
+			if err == nil && o.ClientOrderID == clientOrderID {
+				order, ok = o, true
+			}
+		}
+		return ok
+	}
+	if find(b.GetOpenOrdersWithOptsCtx(ctx, &GetOpenOrdersOpts{MarketSymbol: marketSymbol})) {
+		return
+	}
+	find(b.GetClosedOrdersWithOptsCtx(ctx, &GetClosedOrdersOpts{MarketSymbol: marketSymbol}))
+	return
+}
+
+// Retrieve information on a specific order.
+func (b *Bittrex) GetOrder(orderID string) (order PlacedOrder, err error) {
+	return b.GetOrderCtx(context.Background(), orderID)
+}
+
+// GetOrderCtx is GetOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOrderCtx(ctx context.Context, orderID string) (order PlacedOrder, err error) {
+	r, err := b.client.do(ctx, "GET", "orders/"+url.PathEscape(orderID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &order)
+	return
+}
+
+// Cancel an order.
+func (b *Bittrex) CancelOrder(orderID string) (order PlacedOrder, err error) {
+	return b.CancelOrderCtx(context.Background(), orderID)
+}
+
+// CancelOrderCtx is CancelOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CancelOrderCtx(ctx context.Context, orderID string) (order PlacedOrder, err error) {
+	r, err := b.client.do(ctx, "DELETE", "orders/"+url.PathEscape(orderID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &order)
+	return
+}
+
+type GetOpenOrdersOpts struct {
+	MarketSymbol string
+}
+
+// List open orders.
+func (b *Bittrex) GetOpenOrders() (orders []PlacedOrder, err error) {
+	return b.GetOpenOrdersWithOpts(&GetOpenOrdersOpts{})
+}
+
+// List open orders.
+func (b *Bittrex) GetOpenOrdersWithOpts(opts *GetOpenOrdersOpts) (orders []PlacedOrder, err error) {
+	return b.GetOpenOrdersWithOptsCtx(context.Background(), opts)
+}
+
+// GetOpenOrdersWithOptsCtx is GetOpenOrdersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOpenOrdersWithOptsCtx(ctx context.Context, opts *GetOpenOrdersOpts) (orders []PlacedOrder, err error) {
+	if opts == nil {
+		return orders, errors.New("invalid opts pointer")
+	}
+
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+
+	r, err := b.client.do(ctx, "GET", withQuery("orders/open", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &orders)
+	return
+}
+
+type GetClosedOrdersOpts struct {
+	MarketSymbol string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetClosedOrdersOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List closed orders.
+//
+//	StartDate and EndDate filters apply to the ClosedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ClosedAt field.
+func (b *Bittrex) GetClosedOrders() (orders []PlacedOrder, err error) {
+	return b.GetClosedOrdersWithOpts(&GetClosedOrdersOpts{})
+}
+
+// List closed orders.
+//
+//	StartDate and EndDate filters apply to the ClosedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ClosedAt field.
+func (b *Bittrex) GetClosedOrdersWithOpts(opts *GetClosedOrdersOpts) (orders []PlacedOrder, err error) {
+	return b.GetClosedOrdersWithOptsCtx(context.Background(), opts)
+}
+
+// GetClosedOrdersWithOptsCtx is GetClosedOrdersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetClosedOrdersWithOptsCtx(ctx context.Context, opts *GetClosedOrdersOpts) (orders []PlacedOrder, err error) {
+	if opts == nil {
+		return orders, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("orders/closed", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &orders)
+	return
+}
+
+// Iterate over all closed orders page by page (nil opts for no filter).
+func (b *Bittrex) GetClosedOrdersPager(opts *GetClosedOrdersOpts) *Pager[PlacedOrder] {
+	if opts == nil {
+		opts = &GetClosedOrdersOpts{}
+	}
+	return newPager(b, "orders/closed", opts.query(), opts.PageOpts, func(order PlacedOrder) string { return order.ID })
+}
+
+type CancelAllOpenOrdersOpts struct {
+	MarketSymbol string
+}
+
+// Bulk cancel all open orders.
+func (b *Bittrex) CancelAllOpenOrders() (results []BulkCancelResult, err error) {
+	return b.CancelAllOpenOrdersWithOpts(&CancelAllOpenOrdersOpts{})
+}
+
+// Bulk cancel all open orders (can be limited to a specified market).
+func (b *Bittrex) CancelAllOpenOrdersWithOpts(opts *CancelAllOpenOrdersOpts) (results []BulkCancelResult, err error) {
+	return b.CancelAllOpenOrdersWithOptsCtx(context.Background(), opts)
+}
+
+// CancelAllOpenOrdersWithOptsCtx is CancelAllOpenOrdersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CancelAllOpenOrdersWithOptsCtx(ctx context.Context, opts *CancelAllOpenOrdersOpts) (results []BulkCancelResult, err error) {
+	if opts == nil {
+		return results, errors.New("invalid opts pointer")
+	}
+
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+
+	r, err := b.client.do(ctx, "DELETE", withQuery("orders/open", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &results)
+	return
+}
+
+// Batch
+
+// Batch queues order operations to be submitted in a single request with ExecuteBatch.
+type Batch struct {
+	operations []BatchOperation
+}
+
+// NewBatch returns an empty batch
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// CreateOrder queues the creation of a new order
+func (bt *Batch) CreateOrder(newOrder NewOrder) *Batch {
+	bt.operations = append(bt.operations, BatchOperation{Resource: "order", Operation: "post", Payload: newOrder})
+	return bt
+}
+
+// CancelOrder queues the cancellation of an order
+func (bt *Batch) CancelOrder(orderID string) *Batch {
+	bt.operations = append(bt.operations, BatchOperation{Resource: "order", Operation: "delete", Payload: OrderToCancel{ID: orderID}})
+	return bt
+}
+
+// Len returns the number of queued operations
+func (bt *Batch) Len() int {
+	return len(bt.operations)
+}
+
+// Submit the queued operations in a single request.
+//
+//	Operations are executed in order, and a BatchResult with the status and payload of each one is returned in the same order.
+//	A failed operation does not prevent the following ones from being executed, check each result with Err.
+func (b *Bittrex) ExecuteBatch(batch *Batch) (results []BatchResult, err error) {
+	return b.ExecuteBatchCtx(context.Background(), batch)
+}
+
+// ExecuteBatchCtx is ExecuteBatch with the request bound to ctx, which cancels it when done
+func (b *Bittrex) ExecuteBatchCtx(ctx context.Context, batch *Batch) (results []BatchResult, err error) {
+	if batch == nil || batch.Len() == 0 {
+		return results, errors.New("empty batch")
+	}
+
+	payload, err := json.Marshal(batch.operations)
+	if err != nil {
+		return
+	}
+
+	r, err := b.client.do(ctx, "POST", "batch", string(payload), true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &results)
+	return
+}
+
+// Conditional orders
+
+// Create a new conditional order.
+//
+//	The order in OrderToCreate is placed once the market rate crosses TriggerPrice (LTE or GTE Operation),
+//	or once it moves TrailingStopPercent away from its extreme.
+//	OrderToCancel links the conditional order to another order or conditional order, to build an OCO pair.
+func (b *Bittrex) CreateConditionalOrder(newConditionalOrder NewConditionalOrder) (conditionalOrder ConditionalOrder, err error) {
+	return b.CreateConditionalOrderCtx(context.Background(), newConditionalOrder)
+}
+
+// CreateConditionalOrderCtx is CreateConditionalOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateConditionalOrderCtx(ctx context.Context, newConditionalOrder NewConditionalOrder) (conditionalOrder ConditionalOrder, err error) {
+	payload, err := json.Marshal(newConditionalOrder)
+	if err != nil {
+		return
+	}
+
+	r, err := b.client.do(ctx, "POST", "conditional-orders", string(payload), true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &conditionalOrder)
+	return
+}
+
+// Retrieve information on a specific conditional order.
+func (b *Bittrex) GetConditionalOrder(conditionalOrderID string) (conditionalOrder ConditionalOrder, err error) {
+	return b.GetConditionalOrderCtx(context.Background(), conditionalOrderID)
+}
+
+// GetConditionalOrderCtx is GetConditionalOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetConditionalOrderCtx(ctx context.Context, conditionalOrderID string) (conditionalOrder ConditionalOrder, err error) {
+	r, err := b.client.do(ctx, "GET", "conditional-orders/"+url.PathEscape(conditionalOrderID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &conditionalOrder)
+	return
+}
+
+// Cancel a conditional order.
+func (b *Bittrex) CancelConditionalOrder(conditionalOrderID string) (conditionalOrder ConditionalOrder, err error) {
+	return b.CancelConditionalOrderCtx(context.Background(), conditionalOrderID)
+}
+
+// CancelConditionalOrderCtx is CancelConditionalOrder with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CancelConditionalOrderCtx(ctx context.Context, conditionalOrderID string) (conditionalOrder ConditionalOrder, err error) {
+	r, err := b.client.do(ctx, "DELETE", "conditional-orders/"+url.PathEscape(conditionalOrderID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &conditionalOrder)
+	return
+}
+
+type GetOpenConditionalOrdersOpts struct {
+	MarketSymbol string
+}
+
+// List open conditional orders.
+func (b *Bittrex) GetOpenConditionalOrders() (conditionalOrders []ConditionalOrder, err error) {
+	return b.GetOpenConditionalOrdersWithOpts(&GetOpenConditionalOrdersOpts{})
+}
+
+// List open conditional orders.
+func (b *Bittrex) GetOpenConditionalOrdersWithOpts(opts *GetOpenConditionalOrdersOpts) (conditionalOrders []ConditionalOrder, err error) {
+	return b.GetOpenConditionalOrdersWithOptsCtx(context.Background(), opts)
+}
+
+// GetOpenConditionalOrdersWithOptsCtx is GetOpenConditionalOrdersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOpenConditionalOrdersWithOptsCtx(ctx context.Context, opts *GetOpenConditionalOrdersOpts) (conditionalOrders []ConditionalOrder, err error) {
+	if opts == nil {
+		return conditionalOrders, errors.New("invalid opts pointer")
+	}
+
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+
+	r, err := b.client.do(ctx, "GET", withQuery("conditional-orders/open", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &conditionalOrders)
+	return
+}
+
+type GetClosedConditionalOrdersOpts struct {
+	MarketSymbol string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetClosedConditionalOrdersOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List closed conditional orders.
+//
+//	StartDate and EndDate filters apply to the ClosedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ClosedAt field.
+func (b *Bittrex) GetClosedConditionalOrders() (conditionalOrders []ConditionalOrder, err error) {
+	return b.GetClosedConditionalOrdersWithOpts(&GetClosedConditionalOrdersOpts{})
+}
+
+// List closed conditional orders.
+//
+//	StartDate and EndDate filters apply to the ClosedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ClosedAt field.
+func (b *Bittrex) GetClosedConditionalOrdersWithOpts(opts *GetClosedConditionalOrdersOpts) (conditionalOrders []ConditionalOrder, err error) {
+	return b.GetClosedConditionalOrdersWithOptsCtx(context.Background(), opts)
+}
+
+// GetClosedConditionalOrdersWithOptsCtx is GetClosedConditionalOrdersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetClosedConditionalOrdersWithOptsCtx(ctx context.Context, opts *GetClosedConditionalOrdersOpts) (conditionalOrders []ConditionalOrder, err error) {
+	if opts == nil {
+		return conditionalOrders, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("conditional-orders/closed", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &conditionalOrders)
+	return
+}
+
+// Iterate over all closed conditional orders page by page (nil opts for no filter).
+func (b *Bittrex) GetClosedConditionalOrdersPager(opts *GetClosedConditionalOrdersOpts) *Pager[ConditionalOrder] {
+	if opts == nil {
+		opts = &GetClosedConditionalOrdersOpts{}
+	}
+	return newPager(b, "conditional-orders/closed", opts.query(), opts.PageOpts, func(conditionalOrder ConditionalOrder) string { return conditionalOrder.ID })
+}
+
+// Executions
+
+// Retrieve information on a specific execution.
+//
+//	Executions are only available for trades made in the last year.
+func (b *Bittrex) GetExecution(executionID string) (execution Execution, err error) {
+	return b.GetExecutionCtx(context.Background(), executionID)
+}
+
+// GetExecutionCtx is GetExecution with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetExecutionCtx(ctx context.Context, executionID string) (execution Execution, err error) {
+	r, err := b.client.do(ctx, "GET", "executions/"+url.PathEscape(executionID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &execution)
+	return
+}
+
+type GetExecutionsOpts struct {
+	MarketSymbol string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetExecutionsOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.MarketSymbol) > 0 {
+		q.Set("marketSymbol", strings.ToUpper(opts.MarketSymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List historical executions for account.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetExecutions() (executions []Execution, err error) {
+	return b.GetExecutionsWithOpts(&GetExecutionsOpts{})
+}
+
+// List historical executions for account.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetExecutionsWithOpts(opts *GetExecutionsOpts) (executions []Execution, err error) {
+	return b.GetExecutionsWithOptsCtx(context.Background(), opts)
+}
+
+// GetExecutionsWithOptsCtx is GetExecutionsWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetExecutionsWithOptsCtx(ctx context.Context, opts *GetExecutionsOpts) (executions []Execution, err error) {
+	if opts == nil {
+		return executions, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("executions", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &executions)
+	return
+}
+
+// Iterate over all historical executions page by page (nil opts for no filter).
+func (b *Bittrex) GetExecutionsPager(opts *GetExecutionsOpts) *Pager[Execution] {
+	if opts == nil {
+		opts = &GetExecutionsOpts{}
+	}
+	return newPager(b, "executions", opts.query(), opts.PageOpts, func(execution Execution) string { return execution.ID })
+}
+
+// Get executionId of most recent execution for account.
+func (b *Bittrex) GetExecutionsLastID() (lastID string, err error) {
+	return b.GetExecutionsLastIDCtx(context.Background())
+}
+
+// GetExecutionsLastIDCtx is GetExecutionsLastID with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetExecutionsLastIDCtx(ctx context.Context) (lastID string, err error) {
+	r, err := b.client.do(ctx, "GET", "executions/last-id", "", true)
+	if err != nil {
+		return
+	}
+	var lastIDR ExecutionLastID
+
+	err = json.Unmarshal(r, &lastIDR)
+	lastID = lastIDR.LastID
+	return
+}
+
+// Retrieve executions for a specific order.
+//
+//	Results are sorted in inverse order of execution time, and are limited to the first 1000.
+func (b *Bittrex) GetOrderExecutions(orderID string) (executions []Execution, err error) {
+	return b.GetOrderExecutionsCtx(context.Background(), orderID)
+}
+
+// GetOrderExecutionsCtx is GetOrderExecutions with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOrderExecutionsCtx(ctx context.Context, orderID string) (executions []Execution, err error) {
+	r, err := b.client.do(ctx, "GET", "orders/"+url.PathEscape(orderID)+"/executions", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &executions)
+	return
+}
+
+// Balances
+
+// List account balances across available currencies.
+//
+//	Returns a Balance entry for each currency for which there is either a balance or an address,
+//	their Sequence is the one of the snapshot (0 when the header is missing).
+func (b *Bittrex) GetBalances() (balances []Balance, err error) {
+	balances, _, err = b.GetBalancesWithMeta()
+	return
+}
+
+// List account balances across available currencies.
+//
+//	The response metadata is returned along with them, its Sequence (also set on the results) joins the snapshot with the balance stream.
+func (b *Bittrex) GetBalancesWithMeta() (balances []Balance, meta ResponseMeta, err error) {
+	return b.GetBalancesWithMetaCtx(context.Background())
+}
+
+// GetBalancesWithMetaCtx is GetBalancesWithMeta with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetBalancesWithMetaCtx(ctx context.Context) (balances []Balance, meta ResponseMeta, err error) {
+	r, meta, err := b.client.doWithMeta(ctx, "GET", "balances", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &balances)
+	for i := range balances {
+		balances[i].Sequence = meta.Sequence
+	}
+	return
+}
+
+// Retrieve account balance for a specific currency.
+//
+//	Its Sequence is the one of the snapshot (0 when the header is missing).
+func (b *Bittrex) GetBalance(currencySymbol string) (balance Balance, err error) {
+	return b.GetBalanceCtx(context.Background(), currencySymbol)
+}
+
+// GetBalanceCtx is GetBalance with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetBalanceCtx(ctx context.Context, currencySymbol string) (balance Balance, err error) {
+	r, meta, err := b.client.doWithMeta(ctx, "GET", "balances/"+strings.ToUpper(currencySymbol), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &balance)
+	balance.Sequence = meta.Sequence
+	return
+}
+
+// Get sequence of balances snapshot.
+func (b *Bittrex) GetBalancesSequence() (sequence int, err error) {
+	return b.GetBalancesSequenceCtx(context.Background())
+}
+
+// GetBalancesSequenceCtx is GetBalancesSequence with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetBalancesSequenceCtx(ctx context.Context) (sequence int, err error) {
+	_, meta, err := b.client.doWithMeta(ctx, "HEAD", "balances", "", true)
+	if err != nil {
+		return
+	}
+
+	return parseSequence(meta.Header)
+}
+
+// Account
+
+// Retrieve information for the account associated with the request.
+func (b *Bittrex) GetAccount() (account Account, err error) {
+	return b.GetAccountCtx(context.Background())
+}
+
+// GetAccountCtx is GetAccount with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetAccountCtx(ctx context.Context) (account Account, err error) {
+	r, err := b.client.do(ctx, "GET", "account", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &account)
+	return
+}
+
+// Get 30 day volume for account.
+func (b *Bittrex) GetAccountVolume() (volume AccountVolume, err error) {
+	return b.GetAccountVolumeCtx(context.Background())
+}
+
+// GetAccountVolumeCtx is GetAccountVolume with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetAccountVolumeCtx(ctx context.Context) (volume AccountVolume, err error) {
+	r, err := b.client.do(ctx, "GET", "account/volume", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &volume)
+	return
+}
+
+// Get trading fees for account.
+func (b *Bittrex) GetTradingFees() (fees []CommissionRates, err error) {
+	return b.GetTradingFeesCtx(context.Background())
+}
+
+// GetTradingFeesCtx is GetTradingFees with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetTradingFeesCtx(ctx context.Context) (fees []CommissionRates, err error) {
+	r, err := b.client.do(ctx, "GET", "account/fees/trading", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &fees)
+	return
+}
+
+// Get trading fees for account for a specific market.
+func (b *Bittrex) GetTradingFee(marketSymbol string) (fee CommissionRates, err error) {
+	return b.GetTradingFeeCtx(context.Background(), marketSymbol)
+}
+
+// GetTradingFeeCtx is GetTradingFee with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetTradingFeeCtx(ctx context.Context, marketSymbol string) (fee CommissionRates, err error) {
+	r, err := b.client.do(ctx, "GET", "account/fees/trading/"+strings.ToUpper(marketSymbol), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &fee)
+	return
+}
+
+// Get fiat deposit and withdrawal fees for account.
+func (b *Bittrex) GetFiatFees() (fees []FiatFee, err error) {
+	return b.GetFiatFeesCtx(context.Background())
+}
+
+// GetFiatFeesCtx is GetFiatFees with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetFiatFeesCtx(ctx context.Context) (fees []FiatFee, err error) {
+	r, err := b.client.do(ctx, "GET", "account/fees/fiat", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &fees)
+	return
+}
+
+// Get trading permissions for all markets.
+func (b *Bittrex) GetMarketsPermissions() (policies []MarketPolicy, err error) {
+	return b.GetMarketsPermissionsCtx(context.Background())
+}
+
+// GetMarketsPermissionsCtx is GetMarketsPermissions with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketsPermissionsCtx(ctx context.Context) (policies []MarketPolicy, err error) {
+	r, err := b.client.do(ctx, "GET", "account/permissions/markets", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &policies)
+	return
+}
+
+// Get trading permissions for a single market.
+func (b *Bittrex) GetMarketPermissions(marketSymbol string) (policies []MarketPolicy, err error) {
+	return b.GetMarketPermissionsCtx(context.Background(), marketSymbol)
+}
+
+// GetMarketPermissionsCtx is GetMarketPermissions with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetMarketPermissionsCtx(ctx context.Context, marketSymbol string) (policies []MarketPolicy, err error) {
+	r, err := b.client.do(ctx, "GET", "account/permissions/markets/"+strings.ToUpper(marketSymbol), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &policies)
+	return
+}
+
+// Get currency permissions for all currencies.
+func (b *Bittrex) GetCurrenciesPermissions() (policies []CurrencyPolicy, err error) {
+	return b.GetCurrenciesPermissionsCtx(context.Background())
+}
+
+// GetCurrenciesPermissionsCtx is GetCurrenciesPermissions with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCurrenciesPermissionsCtx(ctx context.Context) (policies []CurrencyPolicy, err error) {
+	r, err := b.client.do(ctx, "GET", "account/permissions/currencies", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &policies)
+	return
+}
+
+// Get currency permissions for a single currency.
+func (b *Bittrex) GetCurrencyPermissions(currencySymbol string) (policies []CurrencyPolicy, err error) {
+	return b.GetCurrencyPermissionsCtx(context.Background(), currencySymbol)
+}
+
+// GetCurrencyPermissionsCtx is GetCurrencyPermissions with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetCurrencyPermissionsCtx(ctx context.Context, currencySymbol string) (policies []CurrencyPolicy, err error) {
+	r, err := b.client.do(ctx, "GET", "account/permissions/currencies/"+strings.ToUpper(currencySymbol), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &policies)
+	return
+}
+
+// Addresses
+
+// List deposit addresses that have been requested or provisioned.
+func (b *Bittrex) GetAddresses() (addresses []Address, err error) {
+	return b.GetAddressesCtx(context.Background())
+}
+
+// GetAddressesCtx is GetAddresses with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetAddressesCtx(ctx context.Context) (addresses []Address, err error) {
+	r, err := b.client.do(ctx, "GET", "addresses", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &addresses)
+	return
+}
+
+// Retrieve the status of the deposit address for a particular currency for which one has been requested or provisioned.
+func (b *Bittrex) GetAddress(currencySymbol string) (address Address, err error) {
+	return b.GetAddressCtx(context.Background(), currencySymbol)
+}
+
+// GetAddressCtx is GetAddress with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetAddressCtx(ctx context.Context, currencySymbol string) (address Address, err error) {
+	r, err := b.client.do(ctx, "GET", "addresses/"+strings.ToUpper(currencySymbol), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &address)
+	return
+}
+
+// Request provisioning of a deposit address for a currency for which no address has been requested or provisioned.
+//
+//	The returned address will have the REQUESTED status until it is provisioned, poll GetAddress to know when it is.
+func (b *Bittrex) CreateAddress(currencySymbol string) (address Address, err error) {
+	return b.CreateAddressCtx(context.Background(), currencySymbol)
+}
+
+// CreateAddressCtx is CreateAddress with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateAddressCtx(ctx context.Context, currencySymbol string) (address Address, err error) {
+	payload, err := json.Marshal(struct {
+		CurrencySymbol string `json:"currencySymbol"`
+	}{strings.ToUpper(currencySymbol)})
+	if err != nil {
+		return
+	}
+
+	r, err := b.client.do(ctx, "POST", "addresses", string(payload), true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &address)
+	return
+}
+
+// Deposits
+
+type GetOpenDepositsOpts struct {
+	Status         string
+	CurrencySymbol string
+}
+
+// List open deposits.
+//
+//	Results are sorted in inverse order of UpdatedAt, and are limited to the first 1000.
+func (b *Bittrex) GetOpenDeposits() (deposits []Deposit, err error) {
+	return b.GetOpenDepositsWithOpts(&GetOpenDepositsOpts{})
+}
+
+// List open deposits.
+//
+//	Results are sorted in inverse order of UpdatedAt, and are limited to the first 1000.
+func (b *Bittrex) GetOpenDepositsWithOpts(opts *GetOpenDepositsOpts) (deposits []Deposit, err error) {
+	return b.GetOpenDepositsWithOptsCtx(context.Background(), opts)
+}
+
+// GetOpenDepositsWithOptsCtx is GetOpenDepositsWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOpenDepositsWithOptsCtx(ctx context.Context, opts *GetOpenDepositsOpts) (deposits []Deposit, err error) {
+	if opts == nil {
+		return deposits, errors.New("invalid opts pointer")
+	}
+
+	q := url.Values{}
+	if len(opts.Status) > 0 {
+		q.Set("status", strings.ToUpper(opts.Status))
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+
+	r, err := b.client.do(ctx, "GET", withQuery("deposits/open", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &deposits)
+	return
+}
+
+type GetClosedDepositsOpts struct {
+	Status         string
+	CurrencySymbol string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetClosedDepositsOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.Status) > 0 {
+		q.Set("status", strings.ToUpper(opts.Status))
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List closed deposits.
+//
+//	StartDate and EndDate filters apply to the CompletedAt field.
+//	Pagination and the sort order of the results are in inverse order of the CompletedAt field.
+func (b *Bittrex) GetClosedDeposits() (deposits []Deposit, err error) {
+	return b.GetClosedDepositsWithOpts(&GetClosedDepositsOpts{})
+}
+
+// List closed deposits.
+//
+//	StartDate and EndDate filters apply to the CompletedAt field.
+//	Pagination and the sort order of the results are in inverse order of the CompletedAt field.
+func (b *Bittrex) GetClosedDepositsWithOpts(opts *GetClosedDepositsOpts) (deposits []Deposit, err error) {
+	return b.GetClosedDepositsWithOptsCtx(context.Background(), opts)
+}
+
+// GetClosedDepositsWithOptsCtx is GetClosedDepositsWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetClosedDepositsWithOptsCtx(ctx context.Context, opts *GetClosedDepositsOpts) (deposits []Deposit, err error) {
+	if opts == nil {
+		return deposits, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("deposits/closed", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &deposits)
+	return
+}
+
+// Iterate over all closed deposits page by page (nil opts for no filter).
+func (b *Bittrex) GetClosedDepositsPager(opts *GetClosedDepositsOpts) *Pager[Deposit] {
+	if opts == nil {
+		opts = &GetClosedDepositsOpts{}
+	}
+	return newPager(b, "deposits/closed", opts.query(), opts.PageOpts, func(deposit Deposit) string { return deposit.ID })
+}
+
+// Retrieves all deposits for this account with the given TxId.
+func (b *Bittrex) GetDepositsByTxID(txID string) (deposits []Deposit, err error) {
+	return b.GetDepositsByTxIDCtx(context.Background(), txID)
+}
+
+// GetDepositsByTxIDCtx is GetDepositsByTxID with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetDepositsByTxIDCtx(ctx context.Context, txID string) (deposits []Deposit, err error) {
+	r, err := b.client.do(ctx, "GET", "deposits/ByTxId/"+url.PathEscape(txID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &deposits)
+	return
+}
+
+// Retrieve information for a specific deposit.
+func (b *Bittrex) GetDeposit(depositID string) (deposit Deposit, err error) {
+	return b.GetDepositCtx(context.Background(), depositID)
+}
+
+// GetDepositCtx is GetDeposit with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetDepositCtx(ctx context.Context, depositID string) (deposit Deposit, err error) {
+	r, err := b.client.do(ctx, "GET", "deposits/"+url.PathEscape(depositID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &deposit)
+	return
+}
+
+// Withdrawals
+
+// Create a new withdrawal.
+//
+//	To initiate a fiat withdrawal, specify a FundsTransferMethodID instead of a CryptoAddress.
+func (b *Bittrex) CreateWithdrawal(newWithdrawal NewWithdrawal) (withdrawal Withdrawal, err error) {
+	return b.CreateWithdrawalCtx(context.Background(), newWithdrawal)
+}
+
+// CreateWithdrawalCtx is CreateWithdrawal with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateWithdrawalCtx(ctx context.Context, newWithdrawal NewWithdrawal) (withdrawal Withdrawal, err error) {
+	payload, err := json.Marshal(newWithdrawal)
+	if err != nil {
+		return
+	}
+
+	r, err := b.client.do(ctx, "POST", "withdrawals", string(payload), true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawal)
+	return
+}
+
+type GetOpenWithdrawalsOpts struct {
+	Status         string
+	CurrencySymbol string
+}
+
+// List open withdrawals.
+//
+//	Results are sorted in inverse order of the CreatedAt field, and are limited to the first 1000.
+func (b *Bittrex) GetOpenWithdrawals() (withdrawals []Withdrawal, err error) {
+	return b.GetOpenWithdrawalsWithOpts(&GetOpenWithdrawalsOpts{})
+}
+
+// List open withdrawals.
+//
+//	Results are sorted in inverse order of the CreatedAt field, and are limited to the first 1000.
+func (b *Bittrex) GetOpenWithdrawalsWithOpts(opts *GetOpenWithdrawalsOpts) (withdrawals []Withdrawal, err error) {
+	return b.GetOpenWithdrawalsWithOptsCtx(context.Background(), opts)
+}
+
+// GetOpenWithdrawalsWithOptsCtx is GetOpenWithdrawalsWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetOpenWithdrawalsWithOptsCtx(ctx context.Context, opts *GetOpenWithdrawalsOpts) (withdrawals []Withdrawal, err error) {
+	if opts == nil {
+		return withdrawals, errors.New("invalid opts pointer")
+	}
+
+	q := url.Values{}
+	if len(opts.Status) > 0 {
+		q.Set("status", strings.ToUpper(opts.Status))
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+
+	r, err := b.client.do(ctx, "GET", withQuery("withdrawals/open", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawals)
+	return
+}
+
+type GetClosedWithdrawalsOpts struct {
+	Status         string
+	CurrencySymbol string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetClosedWithdrawalsOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.Status) > 0 {
+		q.Set("status", strings.ToUpper(opts.Status))
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List closed withdrawals.
+//
+//	StartDate and EndDate filters apply to the CompletedAt field.
+//	Pagination and the sort order of the results are in inverse order of the CompletedAt field.
+func (b *Bittrex) GetClosedWithdrawals() (withdrawals []Withdrawal, err error) {
+	return b.GetClosedWithdrawalsWithOpts(&GetClosedWithdrawalsOpts{})
+}
+
+// List closed withdrawals.
+//
+//	StartDate and EndDate filters apply to the CompletedAt field.
+//	Pagination and the sort order of the results are in inverse order of the CompletedAt field.
+func (b *Bittrex) GetClosedWithdrawalsWithOpts(opts *GetClosedWithdrawalsOpts) (withdrawals []Withdrawal, err error) {
+	return b.GetClosedWithdrawalsWithOptsCtx(context.Background(), opts)
+}
+
+// GetClosedWithdrawalsWithOptsCtx is GetClosedWithdrawalsWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetClosedWithdrawalsWithOptsCtx(ctx context.Context, opts *GetClosedWithdrawalsOpts) (withdrawals []Withdrawal, err error) {
+	if opts == nil {
+		return withdrawals, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("withdrawals/closed", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawals)
+	return
+}
+
+// Iterate over all closed withdrawals page by page (nil opts for no filter).
+func (b *Bittrex) GetClosedWithdrawalsPager(opts *GetClosedWithdrawalsOpts) *Pager[Withdrawal] {
+	if opts == nil {
+		opts = &GetClosedWithdrawalsOpts{}
+	}
+	return newPager(b, "withdrawals/closed", opts.query(), opts.PageOpts, func(withdrawal Withdrawal) string { return withdrawal.ID })
+}
+
+// Retrieves all withdrawals for this account with the given TxId.
+func (b *Bittrex) GetWithdrawalsByTxID(txID string) (withdrawals []Withdrawal, err error) {
+	return b.GetWithdrawalsByTxIDCtx(context.Background(), txID)
+}
+
+// GetWithdrawalsByTxIDCtx is GetWithdrawalsByTxID with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetWithdrawalsByTxIDCtx(ctx context.Context, txID string) (withdrawals []Withdrawal, err error) {
+	r, err := b.client.do(ctx, "GET", "withdrawals/ByTxId/"+url.PathEscape(txID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawals)
+	return
+}
+
+// Retrieve information on a specified withdrawal.
+func (b *Bittrex) GetWithdrawal(withdrawalID string) (withdrawal Withdrawal, err error) {
+	return b.GetWithdrawalCtx(context.Background(), withdrawalID)
+}
+
+// GetWithdrawalCtx is GetWithdrawal with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetWithdrawalCtx(ctx context.Context, withdrawalID string) (withdrawal Withdrawal, err error) {
+	r, err := b.client.do(ctx, "GET", "withdrawals/"+url.PathEscape(withdrawalID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawal)
+	return
+}
+
+// Cancel a withdrawal (withdrawals can only be cancelled if status is REQUESTED, AUTHORIZED, or ERROR_INVALID_ADDRESS).
+func (b *Bittrex) CancelWithdrawal(withdrawalID string) (withdrawal Withdrawal, err error) {
+	return b.CancelWithdrawalCtx(context.Background(), withdrawalID)
+}
+
+// CancelWithdrawalCtx is CancelWithdrawal with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CancelWithdrawalCtx(ctx context.Context, withdrawalID string) (withdrawal Withdrawal, err error) {
+	r, err := b.client.do(ctx, "DELETE", "withdrawals/"+url.PathEscape(withdrawalID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &withdrawal)
+	return
+}
+
+// Returns a list of allowed addresses.
+func (b *Bittrex) GetAllowedAddresses() (addresses []AllowedAddress, err error) {
+	return b.GetAllowedAddressesCtx(context.Background())
+}
+
+// GetAllowedAddressesCtx is GetAllowedAddresses with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetAllowedAddressesCtx(ctx context.Context) (addresses []AllowedAddress, err error) {
+	r, err := b.client.do(ctx, "GET", "withdrawals/allowed-addresses", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &addresses)
+	return
+}
+
+// Subaccounts
+
+// List subaccounts.
+//
+//	This endpoint is only available to partners, and the request must be made with the master account.
+func (b *Bittrex) GetSubaccounts() (subaccounts []Subaccount, err error) {
+	return b.GetSubaccountsCtx(context.Background())
+}
+
+// GetSubaccountsCtx is GetSubaccounts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetSubaccountsCtx(ctx context.Context) (subaccounts []Subaccount, err error) {
+	r, err := b.client.do(ctx, "GET", "subaccounts", "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &subaccounts)
+	return
+}
+
+// Create a new subaccount.
+func (b *Bittrex) CreateSubaccount() (subaccount Subaccount, err error) {
+	return b.CreateSubaccountCtx(context.Background())
+}
+
+// CreateSubaccountCtx is CreateSubaccount with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateSubaccountCtx(ctx context.Context) (subaccount Subaccount, err error) {
+	r, err := b.client.do(ctx, "POST", "subaccounts", "{}", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &subaccount)
+	return
+}
+
+// Retrieve details for a specified subaccount.
+func (b *Bittrex) GetSubaccount(subaccountID string) (subaccount Subaccount, err error) {
+	return b.GetSubaccountCtx(context.Background(), subaccountID)
+}
+
+// GetSubaccountCtx is GetSubaccount with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetSubaccountCtx(ctx context.Context, subaccountID string) (subaccount Subaccount, err error) {
+	r, err := b.client.do(ctx, "GET", "subaccounts/"+url.PathEscape(subaccountID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &subaccount)
+	return
+}
+
+// Transfers
+
+// Executes a new transfer.
+//
+//	Funds are moved to ToSubaccountID, or to the master account when ToMasterAccount is set.
+//	A random RequestID is generated if none is provided, pass your own to make the transfer idempotent.
+func (b *Bittrex) CreateTransfer(newTransfer NewTransfer) (transfer Transfer, err error) {
+	return b.CreateTransferCtx(context.Background(), newTransfer)
+}
+
+// CreateTransferCtx is CreateTransfer with the request bound to ctx, which cancels it when done
+func (b *Bittrex) CreateTransferCtx(ctx context.Context, newTransfer NewTransfer) (transfer Transfer, err error) {
+	if len(newTransfer.RequestID) == 0 {
+		newTransfer.RequestID = uuid.New().String()
+	}
+
+	payload, err := json.Marshal(newTransfer)
+	if err != nil {
+		return
+	}
+
+	r, err := b.client.do(ctx, "POST", "transfers", string(payload), true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &transfer)
+	return
+}
+
+type GetSentTransfersOpts struct {
+	ToSubaccountID  string
+	ToMasterAccount bool
+	CurrencySymbol  string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetSentTransfersOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.ToSubaccountID) > 0 {
+		q.Set("toSubaccountId", opts.ToSubaccountID)
+	}
+	if opts.ToMasterAccount {
+		q.Set("toMasterAccount", "true")
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List sent transfers.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetSentTransfers() (transfers []Transfer, err error) {
+	return b.GetSentTransfersWithOpts(&GetSentTransfersOpts{})
+}
+
+// List sent transfers.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetSentTransfersWithOpts(opts *GetSentTransfersOpts) (transfers []Transfer, err error) {
+	return b.GetSentTransfersWithOptsCtx(context.Background(), opts)
+}
+
+// GetSentTransfersWithOptsCtx is GetSentTransfersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetSentTransfersWithOptsCtx(ctx context.Context, opts *GetSentTransfersOpts) (transfers []Transfer, err error) {
+	if opts == nil {
+		return transfers, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("transfers/sent", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &transfers)
+	return
+}
+
+// Iterate over all sent transfers page by page (nil opts for no filter).
+func (b *Bittrex) GetSentTransfersPager(opts *GetSentTransfersOpts) *Pager[Transfer] {
+	if opts == nil {
+		opts = &GetSentTransfersOpts{}
+	}
+	return newPager(b, "transfers/sent", opts.query(), opts.PageOpts, func(transfer Transfer) string { return transfer.ID })
+}
+
+type GetReceivedTransfersOpts struct {
+	FromSubaccountID  string
+	FromMasterAccount bool
+	CurrencySymbol    string
+	PageOpts
+}
+
+// query encodes the filters and pagination parameters
+func (opts *GetReceivedTransfersOpts) query() url.Values {
+	q := url.Values{}
+	if len(opts.FromSubaccountID) > 0 {
+		q.Set("fromSubaccountId", opts.FromSubaccountID)
+	}
+	if opts.FromMasterAccount {
+		q.Set("fromMasterAccount", "true")
+	}
+	if len(opts.CurrencySymbol) > 0 {
+		q.Set("currencySymbol", strings.ToUpper(opts.CurrencySymbol))
+	}
+	opts.PageOpts.encode(q)
+
+	return q
+}
+
+// List received transfers.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetReceivedTransfers() (transfers []Transfer, err error) {
+	return b.GetReceivedTransfersWithOpts(&GetReceivedTransfersOpts{})
+}
+
+// List received transfers.
+//
+//	StartDate and EndDate filters apply to the ExecutedAt field.
+//	Pagination and the sort order of the results are in inverse order of the ExecutedAt field.
+func (b *Bittrex) GetReceivedTransfersWithOpts(opts *GetReceivedTransfersOpts) (transfers []Transfer, err error) {
+	return b.GetReceivedTransfersWithOptsCtx(context.Background(), opts)
+}
+
+// GetReceivedTransfersWithOptsCtx is GetReceivedTransfersWithOpts with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetReceivedTransfersWithOptsCtx(ctx context.Context, opts *GetReceivedTransfersOpts) (transfers []Transfer, err error) {
+	if opts == nil {
+		return transfers, errors.New("invalid opts pointer")
+	}
+
+	q := opts.query()
+
+	r, err := b.client.do(ctx, "GET", withQuery("transfers/received", q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &transfers)
+	return
+}
+
+// Iterate over all received transfers page by page (nil opts for no filter).
+func (b *Bittrex) GetReceivedTransfersPager(opts *GetReceivedTransfersOpts) *Pager[Transfer] {
+	if opts == nil {
+		opts = &GetReceivedTransfersOpts{}
+	}
+	return newPager(b, "transfers/received", opts.query(), opts.PageOpts, func(transfer Transfer) string { return transfer.ID })
+}
+
+// Retrieve information on the specified transfer.
+func (b *Bittrex) GetTransfer(transferID string) (transfer Transfer, err error) {
+	return b.GetTransferCtx(context.Background(), transferID)
+}
+
+// GetTransferCtx is GetTransfer with the request bound to ctx, which cancels it when done
+func (b *Bittrex) GetTransferCtx(ctx context.Context, transferID string) (transfer Transfer, err error) {
+	r, err := b.client.do(ctx, "GET", "transfers/"+url.PathEscape(transferID), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &transfer)
+	return
+}
+
+// Ping
+
+// Pings the service
+func (b *Bittrex) Ping() (serverTime int64, err error) {
+	return b.PingCtx(context.Background())
+}
+
+// PingCtx is Ping with the request bound to ctx, which cancels it when done
+func (b *Bittrex) PingCtx(ctx context.Context) (serverTime int64, err error) {
+	r, err := b.client.do(ctx, "GET", "ping", "", false)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package bittrex
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -42,6 +43,7 @@ type MarketSummary struct {
 	QuoteVolume   decimal.Decimal `json:"quoteVolume"`
 	PercentChange decimal.Decimal `json:"percentChange"`
 	UpdatedAt     time.Time       `json:"updatedAt"`
+	Sequence      int             `json:"sequence"`
 }
 
 type Ticker struct {
@@ -49,18 +51,25 @@ type Ticker struct {
 	LastTradeRate decimal.Decimal `json:"lastTradeRate"`
 	BidRate       decimal.Decimal `json:"bidRate"`
 	AskRate       decimal.Decimal `json:"askRate"`
+	Sequence      int             `json:"sequence"`
 }
 
-type Order struct {
+type OrderBookEntry struct {
 	Quantity decimal.Decimal `json:"quantity"`
 	Rate     decimal.Decimal `json:"rate"`
 }
 
+// Order is a level of an order book.
+//
+// Deprecated: use OrderBookEntry, orders placed on a market are PlacedOrder.
+type Order = OrderBookEntry
+
 type OrderBook struct {
-	Symbol string
-	Depth  int
-	Bid    []Order `json:"bid"`
-	Ask    []Order `json:"ask"`
+	Symbol   string
+	Depth    int
+	Bid      []OrderBookEntry `json:"bid"`
+	Ask      []OrderBookEntry `json:"ask"`
+	Sequence int              `json:"sequence"`
 }
 
 type Trade struct {
@@ -70,6 +79,7 @@ type Trade struct {
 	Quantity   decimal.Decimal `json:"quantity"`
 	Rate       decimal.Decimal `json:"rate"`
 	TakerSide  string          `json:"takerSide"`
+	Sequence   int             `json:"sequence"`
 }
 
 type Ping struct {
@@ -86,4 +96,337 @@ type Candle struct {
 	Close        decimal.Decimal `json:"close"`
 	Volume       decimal.Decimal `json:"volume"`
 	QuoteVolume  decimal.Decimal `json:"quoteVolume"`
+	Sequence     int             `json:"sequence"`
+}
+
+// PlacedOrder is an order placed on a market, as returned by the order endpoints
+type PlacedOrder struct {
+	ID            string          `json:"id"`
+	MarketSymbol  string          `json:"marketSymbol"`
+	Direction     string          `json:"direction"`
+	Type          string          `json:"type"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Limit         decimal.Decimal `json:"limit"`
+	Ceiling       decimal.Decimal `json:"ceiling"`
+	TimeInForce   string          `json:"timeInForce"`
+	ClientOrderID string          `json:"clientOrderId"`
+	FillQuantity  decimal.Decimal `json:"fillQuantity"`
+	Commission    decimal.Decimal `json:"commission"`
+	Proceeds      decimal.Decimal `json:"proceeds"`
+	Status        string          `json:"status"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+	ClosedAt      time.Time       `json:"closedAt"`
+	OrderToCancel *OrderToCancel  `json:"orderToCancel"`
+}
+
+type NewOrder struct {
+	MarketSymbol  string          `json:"marketSymbol"`
+	Direction     string          `json:"direction"`
+	Type          string          `json:"type"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Ceiling       decimal.Decimal `json:"ceiling"`
+	Limit         decimal.Decimal `json:"limit"`
+	TimeInForce   string          `json:"timeInForce"`
+	ClientOrderID string          `json:"clientOrderId"`
+	UseAwards     bool            `json:"useAwards"`
+}
+
+// MarshalJSON omits the quantity, ceiling and limit fields left at zero, since each of them only applies to some order types.
+func (o NewOrder) MarshalJSON() ([]byte, error) {
+	var quantity, ceiling, limit *decimal.Decimal
+	if !o.Quantity.IsZero() {
+		quantity = &o.Quantity
+	}
+	if !o.Ceiling.IsZero() {
+		ceiling = &o.Ceiling
+	}
+	if !o.Limit.IsZero() {
+		limit = &o.Limit
+	}
+	return json.Marshal(struct {
+		MarketSymbol  string           `json:"marketSymbol"`
+		Direction     string           `json:"direction"`
+		Type          string           `json:"type"`
+		Quantity      *decimal.Decimal `json:"quantity,omitempty"`
+		Ceiling       *decimal.Decimal `json:"ceiling,omitempty"`
+		Limit         *decimal.Decimal `json:"limit,omitempty"`
+		TimeInForce   string           `json:"timeInForce"`
+		ClientOrderID string           `json:"clientOrderId,omitempty"`
+		UseAwards     bool             `json:"useAwards,omitempty"`
+	}{o.MarketSymbol, o.Direction, o.Type, quantity, ceiling, limit, o.TimeInForce, o.ClientOrderID, o.UseAwards})
+}
+
+type BulkCancelResult struct {
+	ID         string      `json:"id"`
+	StatusCode string      `json:"statusCode"`
+	Result     PlacedOrder `json:"result"`
+}
+
+type Balance struct {
+	CurrencySymbol string          `json:"currencySymbol"`
+	Total          decimal.Decimal `json:"total"`
+	Available      decimal.Decimal `json:"available"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+	Sequence       int             `json:"sequence"`
+}
+
+type ErrorDetail struct {
+	Code   string      `json:"code"`
+	Detail string      `json:"detail"`
+	Data   interface{} `json:"data"`
+}
+
+type Deposit struct {
+	ID                    string          `json:"id"`
+	CurrencySymbol        string          `json:"currencySymbol"`
+	Quantity              decimal.Decimal `json:"quantity"`
+	CryptoAddress         string          `json:"cryptoAddress"`
+	FundsTransferMethodID string          `json:"fundsTransferMethodId"`
+	CryptoAddressTag      string          `json:"cryptoAddressTag"`
+	TxID                  string          `json:"txId"`
+	Confirmations         int             `json:"confirmations"`
+	UpdatedAt             time.Time       `json:"updatedAt"`
+	CompletedAt           time.Time       `json:"completedAt"`
+	Status                string          `json:"status"`
+	Source                string          `json:"source"`
+	AccountID             string          `json:"accountId"`
+	Error                 *ErrorDetail    `json:"error"`
+}
+
+type Withdrawal struct {
+	ID                    string          `json:"id"`
+	CurrencySymbol        string          `json:"currencySymbol"`
+	Quantity              decimal.Decimal `json:"quantity"`
+	CryptoAddress         string          `json:"cryptoAddress"`
+	CryptoAddressTag      string          `json:"cryptoAddressTag"`
+	FundsTransferMethodID string          `json:"fundsTransferMethodId"`
+	TxCost                decimal.Decimal `json:"txCost"`
+	TxID                  string          `json:"txId"`
+	Status                string          `json:"status"`
+	CreatedAt             time.Time       `json:"createdAt"`
+	CompletedAt           time.Time       `json:"completedAt"`
+	ClientWithdrawalID    string          `json:"clientWithdrawalId"`
+	Target                string          `json:"target"`
+	AccountID             string          `json:"accountId"`
+	Error                 *ErrorDetail    `json:"error"`
+}
+
+type NewWithdrawal struct {
+	CurrencySymbol        string          `json:"currencySymbol"`
+	Quantity              decimal.Decimal `json:"quantity"`
+	CryptoAddress         string          `json:"cryptoAddress"`
+	CryptoAddressTag      string          `json:"cryptoAddressTag"`
+	FundsTransferMethodID string          `json:"fundsTransferMethodId"`
+	ClientWithdrawalID    string          `json:"clientWithdrawalId"`
+	WithdrawEntireBalance bool            `json:"withdrawEntireBalance"`
+}
+
+// MarshalJSON omits the optional fields left empty, including the quantity when the entire balance is withdrawn.
+func (w NewWithdrawal) MarshalJSON() ([]byte, error) {
+	var quantity *decimal.Decimal
+	if !w.Quantity.IsZero() {
+		quantity = &w.Quantity
+	}
+	return json.Marshal(struct {
+		CurrencySymbol        string           `json:"currencySymbol"`
+		Quantity              *decimal.Decimal `json:"quantity,omitempty"`
+		CryptoAddress         string           `json:"cryptoAddress,omitempty"`
+		CryptoAddressTag      string           `json:"cryptoAddressTag,omitempty"`
+		FundsTransferMethodID string           `json:"fundsTransferMethodId,omitempty"`
+		ClientWithdrawalID    string           `json:"clientWithdrawalId,omitempty"`
+		WithdrawEntireBalance bool             `json:"withdrawEntireBalance,omitempty"`
+	}{w.CurrencySymbol, quantity, w.CryptoAddress, w.CryptoAddressTag, w.FundsTransferMethodID, w.ClientWithdrawalID, w.WithdrawEntireBalance})
+}
+
+type AllowedAddress struct {
+	CurrencySymbol   string    `json:"currencySymbol"`
+	CreatedAt        time.Time `json:"createdAt"`
+	Status           string    `json:"status"`
+	ActiveAt         time.Time `json:"activeAt"`
+	CryptoAddress    string    `json:"cryptoAddress"`
+	CryptoAddressTag string    `json:"cryptoAddressTag"`
+}
+
+type Address struct {
+	Status           string `json:"status"`
+	CurrencySymbol   string `json:"currencySymbol"`
+	CryptoAddress    string `json:"cryptoAddress"`
+	CryptoAddressTag string `json:"cryptoAddressTag"`
+}
+
+type Execution struct {
+	ID           string          `json:"id"`
+	MarketSymbol string          `json:"marketSymbol"`
+	ExecutedAt   time.Time       `json:"executedAt"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	Rate         decimal.Decimal `json:"rate"`
+	OrderID      string          `json:"orderId"`
+	Commission   decimal.Decimal `json:"commission"`
+	IsTaker      bool            `json:"isTaker"`
+}
+
+type ExecutionLastID struct {
+	LastID string `json:"lastId"`
+}
+
+type OrderToCancel struct {
+	Type string `json:"type,omitempty"`
+	ID   string `json:"id"`
+}
+
+type ConditionalOrder struct {
+	ID                       string          `json:"id"`
+	MarketSymbol             string          `json:"marketSymbol"`
+	Operation                string          `json:"operation"`
+	TriggerPrice             decimal.Decimal `json:"triggerPrice"`
+	TrailingStopPercent      decimal.Decimal `json:"trailingStopPercent"`
+	CreatedOrderID           string          `json:"createdOrderId"`
+	OrderToCreate            *NewOrder       `json:"orderToCreate"`
+	OrderToCancel            *OrderToCancel  `json:"orderToCancel"`
+	ClientConditionalOrderID string          `json:"clientConditionalOrderId"`
+	Status                   string          `json:"status"`
+	OrderCreationErrorCode   string          `json:"orderCreationErrorCode"`
+	CreatedAt                time.Time       `json:"createdAt"`
+	UpdatedAt                time.Time       `json:"updatedAt"`
+	ClosedAt                 time.Time       `json:"closedAt"`
+}
+
+type NewConditionalOrder struct {
+	MarketSymbol             string          `json:"marketSymbol"`
+	Operation                string          `json:"operation"`
+	TriggerPrice             decimal.Decimal `json:"triggerPrice"`
+	TrailingStopPercent      decimal.Decimal `json:"trailingStopPercent"`
+	OrderToCreate            *NewOrder       `json:"orderToCreate"`
+	OrderToCancel            *OrderToCancel  `json:"orderToCancel"`
+	ClientConditionalOrderID string          `json:"clientConditionalOrderId"`
+}
+
+// MarshalJSON omits the trigger price or trailing stop percent left at zero, since only one of them may be set.
+func (o NewConditionalOrder) MarshalJSON() ([]byte, error) {
+	var triggerPrice, trailingStopPercent *decimal.Decimal
+	if !o.TriggerPrice.IsZero() {
+		triggerPrice = &o.TriggerPrice
+	}
+	if !o.TrailingStopPercent.IsZero() {
+		trailingStopPercent = &o.TrailingStopPercent
+	}
+	return json.Marshal(struct {
+		MarketSymbol             string           `json:"marketSymbol"`
+		Operation                string           `json:"operation"`
+		TriggerPrice             *decimal.Decimal `json:"triggerPrice,omitempty"`
+		TrailingStopPercent      *decimal.Decimal `json:"trailingStopPercent,omitempty"`
+		OrderToCreate            *NewOrder        `json:"orderToCreate,omitempty"`
+		OrderToCancel            *OrderToCancel   `json:"orderToCancel,omitempty"`
+		ClientConditionalOrderID string           `json:"clientConditionalOrderId,omitempty"`
+	}{o.MarketSymbol, o.Operation, triggerPrice, trailingStopPercent, o.OrderToCreate, o.OrderToCancel, o.ClientConditionalOrderID})
+}
+
+type BatchOperation struct {
+	Resource  string      `json:"resource"`
+	Operation string      `json:"operation"`
+	Payload   interface{} `json:"payload"`
+}
+
+type BatchResult struct {
+	Status  int             `json:"status"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Err returns the error reported for the operation as an *APIError, or nil if it succeeded
+func (r BatchResult) Err() error {
+	if r.Status >= 200 && r.Status < 300 {
+		return nil
+	}
+	e := &APIError{StatusCode: r.Status}
+	var detail ErrorDetail
+	if json.Unmarshal(r.Payload, &detail) == nil {
+		e.Code = detail.Code
+		e.Detail = detail.Detail
+		e.Data = detail.Data
+	}
+	return e
+}
+
+// Order decodes the order returned by a successful create or cancel order operation
+func (r BatchResult) Order() (order PlacedOrder, err error) {
+	if err = r.Err(); err != nil {
+		return
+	}
+	err = json.Unmarshal(r.Payload, &order)
+	return
+}
+
+type Subaccount struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Transfer struct {
+	ID                string          `json:"id"`
+	RequestID         string          `json:"requestId"`
+	ToSubaccountID    string          `json:"toSubaccountId"`
+	ToMasterAccount   bool            `json:"toMasterAccount"`
+	FromSubaccountID  string          `json:"fromSubaccountId"`
+	FromMasterAccount bool            `json:"fromMasterAccount"`
+	CurrencySymbol    string          `json:"currencySymbol"`
+	Amount            decimal.Decimal `json:"amount"`
+	ExecutedAt        time.Time       `json:"executedAt"`
+}
+
+type NewTransfer struct {
+	ToSubaccountID  string          `json:"toSubaccountId,omitempty"`
+	RequestID       string          `json:"requestId"`
+	CurrencySymbol  string          `json:"currencySymbol"`
+	Amount          decimal.Decimal `json:"amount"`
+	ToMasterAccount bool            `json:"toMasterAccount,omitempty"`
+}
+
+type Account struct {
+	SubaccountID  string   `json:"subaccountId"`
+	AccountID     string   `json:"accountId"`
+	ActionsNeeded []string `json:"actionsNeeded"`
+}
+
+type AccountVolume struct {
+	Updated      time.Time       `json:"updated"`
+	Volume30days decimal.Decimal `json:"volume30days"`
+}
+
+type CommissionRates struct {
+	MarketSymbol string          `json:"marketSymbol"`
+	MakerRate    decimal.Decimal `json:"makerRate"`
+	TakerRate    decimal.Decimal `json:"takerRate"`
+}
+
+type FiatFee struct {
+	CurrencySymbol    string          `json:"currencySymbol"`
+	TransferType      string          `json:"transferType"`
+	DepositFixed      decimal.Decimal `json:"depositFixed"`
+	DepositPercent    decimal.Decimal `json:"depositPercent"`
+	WithdrawalFixed   decimal.Decimal `json:"withdrawalFixed"`
+	WithdrawalPercent decimal.Decimal `json:"withdrawalPercent"`
+}
+
+type MarketPolicy struct {
+	Symbol string `json:"symbol"`
+	View   bool   `json:"view"`
+	Buy    bool   `json:"buy"`
+	Sell   bool   `json:"sell"`
+}
+
+type CurrencyPolicy struct {
+	Symbol  string `json:"symbol"`
+	View    bool   `json:"view"`
+	Deposit struct {
+		Blockchain   bool `json:"blockchain"`
+		CreditCard   bool `json:"creditCard"`
+		WireTransfer bool `json:"wireTransfer"`
+		Ach          bool `json:"ach"`
+	} `json:"deposit"`
+	Withdraw struct {
+		Blockchain   bool `json:"blockchain"`
+		WireTransfer bool `json:"wireTransfer"`
+		Ach          bool `json:"ach"`
+	} `json:"withdraw"`
 }
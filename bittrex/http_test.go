@@ -1,11 +1,54 @@
 package bittrex
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
+// newTestBittrex returns a bittrex struct pointed at a local server answering with handler
+func newTestBittrex(t *testing.T, handler http.HandlerFunc) *Bittrex {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	bt := New("key", "secret")
+	assert.NoError(t, bt.SetAPIBase(server.URL))
+	return bt
+}
+
+func TestBittrex_SetAPIBase(t *testing.T) {
+	bt := New("", "")
+	assert.NoError(t, bt.SetAPIBase("http://localhost:8080"))
+	assert.Equal(t, "http://localhost:8080/", bt.client.apiBase)
+	assert.Error(t, bt.SetAPIBase("localhost:8080"))
+	assert.Error(t, bt.SetAPIBase("ftp://localhost"))
+}
+
+func TestBittrex_SetSocketBase(t *testing.T) {
+	bt := New("", "")
+	assert.NoError(t, bt.SetSocketBase("ws://localhost:8080"))
+	assert.Equal(t, "http", bt.client.wsScheme)
+	assert.Equal(t, "localhost:8080", bt.client.wsHost)
+	assert.Error(t, bt.SetSocketBase("localhost"))
+}
+
+func TestBittrex_SetWebsocketDialer(t *testing.T) {
+	bt := New("", "")
+	assert.Equal(t, websocket.DefaultDialer, bt.client.wsDialer)
+	dialer := &websocket.Dialer{HandshakeTimeout: time.Second}
+	assert.NoError(t, bt.SetWebsocketDialer(dialer))
+	assert.Equal(t, dialer, bt.client.wsDialer)
+	assert.Error(t, bt.SetWebsocketDialer(nil))
+}
+
 // Currencies
 
 func TestCurrenciesService_GetCurrencies(t *testing.T) {
@@ -84,6 +127,43 @@ func TestMarketsService_GetOrderBook(t *testing.T) {
 	assert.NotEmpty(t, orderBook.Bid[0].Quantity)
 }
 
+func TestMarketsService_GetWithMetaLocal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Sequence", "42")
+		switch r.URL.Path {
+		case "/v3/markets/ETH-USD/orderbook":
+			assert.Equal(t, "500", r.URL.Query().Get("depth"))
+			_, _ = w.Write([]byte(`{"bid":[{"quantity":"1","rate":"1500"}],"ask":[{"quantity":"2","rate":"1501"}]}`))
+		case "/v3/markets/tickers":
+			_, _ = w.Write([]byte(`[{"symbol":"ETH-USD","lastTradeRate":"1500"}]`))
+		case "/v3/markets/summaries":
+			w.Header().Del("Sequence")
+			_, _ = w.Write([]byte(`[{"symbol":"ETH-USD","high":"1600"}]`))
+		}
+	})
+
+	orderBook, meta, err := bt.GetOrderBookWithMeta("ETH-USD", &GetOrderBookOpts{Depth: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, meta.Sequence)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "42", meta.Header.Get("Sequence"))
+	assert.Equal(t, "1501", orderBook.Ask[0].Rate.String())
+	assert.Equal(t, 42, orderBook.Sequence)
+	_, _, err = bt.GetOrderBookWithMeta("ETH-USD", nil)
+	assert.Error(t, err)
+
+	tickers, meta, err := bt.GetMarketsTickersWithMeta()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, meta.Sequence)
+	assert.Equal(t, "ETH-USD", tickers[0].Symbol)
+	assert.Equal(t, 42, tickers[0].Sequence)
+
+	summaries, meta, err := bt.GetMarketsSummariesWithMeta()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, meta.Sequence)
+	assert.Equal(t, "1600", summaries[0].High.String())
+}
+
 func TestMarketsService_GetTrades(t *testing.T) {
 	bt := New("", "")
 	trades, err := bt.GetTrades("ETH-USD")
@@ -157,6 +237,596 @@ func TestMarketsService_GetCandlesHistory(t *testing.T) {
 	assert.Empty(t, candles)
 }
 
+// Orders
+
+func TestOrdersService_NewOrderJSON(t *testing.T) {
+	newOrder := NewOrder{
+		MarketSymbol: "ETH-USD",
+		Direction:    ORDERDIRECTION_BUY,
+		Type:         ORDERTYPE_LIMIT,
+		Quantity:     decimal.RequireFromString("0.5"),
+		Limit:        decimal.RequireFromString("1000"),
+		TimeInForce:  TIMEINFORCE_GOODTILCANCELLED,
+	}
+	payload, err := json.Marshal(newOrder)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"marketSymbol":"ETH-USD","direction":"BUY","type":"LIMIT","quantity":"0.5","limit":"1000","timeInForce":"GOOD_TIL_CANCELLED"}`, string(payload))
+}
+
+func TestOrdersService_CreateOrder(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.CreateOrder(NewOrder{MarketSymbol: "ETH-USD", Direction: ORDERDIRECTION_BUY, Type: ORDERTYPE_MARKET, Quantity: decimal.NewFromInt(1), TimeInForce: TIMEINFORCE_IMMEDIATEORCANCEL})
+	assert.Error(t, err)
+}
+
+func TestOrdersService_CreateOrderLocal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v3/orders", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"marketSymbol":"ETH-USD","direction":"SELL","type":"LIMIT","quantity":"2","limit":"1500.5","timeInForce":"GOOD_TIL_CANCELLED","clientOrderId":"client"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"id","marketSymbol":"ETH-USD","direction":"SELL","type":"LIMIT","quantity":"2","limit":"1500.5","fillQuantity":"0","status":"OPEN","createdAt":"2021-10-01T00:00:00Z"}`))
+	})
+	order, err := bt.CreateOrder(NewOrder{MarketSymbol: "ETH-USD", Direction: ORDERDIRECTION_SELL, Type: ORDERTYPE_LIMIT, Quantity: decimal.NewFromInt(2), Limit: decimal.RequireFromString("1500.5"), TimeInForce: TIMEINFORCE_GOODTILCANCELLED, ClientOrderID: "client"})
+	assert.NoError(t, err)
+	assert.Equal(t, "id", order.ID)
+	assert.Equal(t, ORDERSTATUS_OPEN, order.Status)
+	assert.Equal(t, "1500.5", order.Limit.String())
+}
+
+func TestOrdersService_CreateOrderDuplicate(t *testing.T) {
+	var posts int32
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v3/orders":
+			if atomic.AddInt32(&posts, 1) == 1 {
+				// The order is created but the response is lost
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code":"DUPLICATE_CLIENT_ORDER_ID"}`))
+		case "GET /v3/orders/open":
+			assert.Equal(t, "ETH-USD", r.URL.Query().Get("marketSymbol"))
+			_, _ = w.Write([]byte(`[{"id":"other","marketSymbol":"ETH-USD","clientOrderId":"other","status":"OPEN"},{"id":"id","marketSymbol":"ETH-USD","clientOrderId":"client","status":"OPEN"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	bt.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	newOrder := NewOrder{MarketSymbol: "ETH-USD", Direction: ORDERDIRECTION_SELL, Type: ORDERTYPE_LIMIT, Quantity: decimal.NewFromInt(2), Limit: decimal.RequireFromString("1500.5"), TimeInForce: TIMEINFORCE_GOODTILCANCELLED, ClientOrderID: "client"}
+	order, err := bt.CreateOrder(newOrder)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", order.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&posts))
+
+	// A conflict on the first attempt is not caused by the client
+	atomic.StoreInt32(&posts, 1)
+	_, err = bt.CreateOrder(newOrder)
+	assert.ErrorIs(t, err, ErrDuplicateClientOrderID)
+}
+
+func TestOrdersService_GetOpenOrders(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetOpenOrders()
+	assert.Error(t, err)
+	_, err = bt.GetOpenOrdersWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestOrdersService_GetClosedOrders(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetClosedOrders()
+	assert.Error(t, err)
+	_, err = bt.GetClosedOrdersWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestOrdersService_CancelAllOpenOrders(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.CancelAllOpenOrdersWithOpts(&CancelAllOpenOrdersOpts{MarketSymbol: "ETH-USD"})
+	assert.Error(t, err)
+}
+
+// Batch
+
+func TestBatchService_ExecuteBatch(t *testing.T) {
+	bt := New("", "")
+	batch := NewBatch().
+		CreateOrder(NewOrder{MarketSymbol: "ETH-USD", Direction: ORDERDIRECTION_BUY, Type: ORDERTYPE_LIMIT, Quantity: decimal.NewFromInt(1), Limit: decimal.NewFromInt(1000), TimeInForce: TIMEINFORCE_POSTONLYGOODTILCANCELLED}).
+		CancelOrder("id")
+	assert.Equal(t, 2, batch.Len())
+	payload, err := json.Marshal(batch.operations)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"resource":"order","operation":"post","payload":{"marketSymbol":"ETH-USD","direction":"BUY","type":"LIMIT","quantity":"1","limit":"1000","timeInForce":"POST_ONLY_GOOD_TIL_CANCELLED"}},{"resource":"order","operation":"delete","payload":{"id":"id"}}]`, string(payload))
+	_, err = bt.ExecuteBatch(batch)
+	assert.Error(t, err)
+	_, err = bt.ExecuteBatch(NewBatch())
+	assert.Error(t, err)
+}
+
+func TestBatchService_BatchResult(t *testing.T) {
+	var results []BatchResult
+	err := json.Unmarshal([]byte(`[{"status":201,"payload":{"id":"id","status":"OPEN"}},{"status":409,"payload":{"code":"INSUFFICIENT_FUNDS"}}]`), &results)
+	assert.NoError(t, err)
+	order, err := results[0].Order()
+	assert.NoError(t, err)
+	assert.Equal(t, "id", order.ID)
+	_, err = results[1].Order()
+	assert.EqualError(t, err, "409: INSUFFICIENT_FUNDS")
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+// Conditional orders
+
+func TestConditionalOrdersService_NewConditionalOrderJSON(t *testing.T) {
+	newConditionalOrder := NewConditionalOrder{
+		MarketSymbol: "ETH-USD",
+		Operation:    CONDITIONALOPERATION_LTE,
+		TriggerPrice: decimal.RequireFromString("900"),
+		OrderToCreate: &NewOrder{
+			MarketSymbol: "ETH-USD",
+			Direction:    ORDERDIRECTION_SELL,
+			Type:         ORDERTYPE_MARKET,
+			Quantity:     decimal.RequireFromString("0.5"),
+			TimeInForce:  TIMEINFORCE_IMMEDIATEORCANCEL,
+		},
+		OrderToCancel: &OrderToCancel{Type: ORDERTOCANCELTYPE_ORDER, ID: "id"},
+	}
+	payload, err := json.Marshal(newConditionalOrder)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"marketSymbol":"ETH-USD","operation":"LTE","triggerPrice":"900","orderToCreate":{"marketSymbol":"ETH-USD","direction":"SELL","type":"MARKET","quantity":"0.5","timeInForce":"IMMEDIATE_OR_CANCEL"},"orderToCancel":{"type":"ORDER","id":"id"}}`, string(payload))
+}
+
+func TestConditionalOrdersService_CreateConditionalOrder(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v3/conditional-orders", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"marketSymbol":"ETH-USD","operation":"GTE","trailingStopPercent":"5"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"conditional","marketSymbol":"ETH-USD","operation":"GTE","trailingStopPercent":"5","orderToCreate":{"marketSymbol":"ETH-USD","direction":"BUY","type":"MARKET","quantity":"1","timeInForce":"IMMEDIATE_OR_CANCEL"},"orderToCancel":{"type":"ORDER","id":"order"},"status":"OPEN","createdAt":"2021-10-01T00:00:00Z","updatedAt":"2021-10-01T00:00:00Z"}`))
+	})
+	conditionalOrder, err := bt.CreateConditionalOrder(NewConditionalOrder{MarketSymbol: "ETH-USD", Operation: CONDITIONALOPERATION_GTE, TrailingStopPercent: decimal.NewFromInt(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, "conditional", conditionalOrder.ID)
+	assert.Equal(t, "5", conditionalOrder.TrailingStopPercent.String())
+	assert.True(t, conditionalOrder.TriggerPrice.IsZero())
+	assert.Equal(t, ORDERDIRECTION_BUY, conditionalOrder.OrderToCreate.Direction)
+	assert.Equal(t, &OrderToCancel{Type: ORDERTOCANCELTYPE_ORDER, ID: "order"}, conditionalOrder.OrderToCancel)
+	assert.Equal(t, CONDITIONALORDERSTATUS_OPEN, conditionalOrder.Status)
+	assert.True(t, conditionalOrder.ClosedAt.IsZero())
+}
+
+func TestConditionalOrdersService_CancelConditionalOrder(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v3/conditional-orders/conditional%2F1", r.URL.EscapedPath())
+		_, _ = w.Write([]byte(`{"id":"conditional/1","status":"CANCELLED","closedAt":"2021-10-02T00:00:00Z"}`))
+	})
+	conditionalOrder, err := bt.CancelConditionalOrder("conditional/1")
+	assert.NoError(t, err)
+	assert.Equal(t, CONDITIONALORDERSTATUS_CANCELLED, conditionalOrder.Status)
+	assert.Equal(t, time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC), conditionalOrder.ClosedAt)
+}
+
+func TestConditionalOrdersService_GetOpenConditionalOrders(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/conditional-orders/open", r.URL.Path)
+		assert.Equal(t, "marketSymbol=ETH-USD", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"conditional","marketSymbol":"ETH-USD","operation":"GTE","trailingStopPercent":"5","orderToCreate":{"marketSymbol":"ETH-USD","direction":"BUY","type":"MARKET","quantity":"1","timeInForce":"IMMEDIATE_OR_CANCEL"},"orderToCancel":{"type":"ORDER","id":"order"},"status":"OPEN","createdAt":"2021-10-01T00:00:00Z","updatedAt":"2021-10-01T00:00:00Z"}]`))
+	})
+	conditionalOrders, err := bt.GetOpenConditionalOrdersWithOpts(&GetOpenConditionalOrdersOpts{MarketSymbol: "eth-usd"})
+	assert.NoError(t, err)
+	assert.Len(t, conditionalOrders, 1)
+	assert.Equal(t, CONDITIONALOPERATION_GTE, conditionalOrders[0].Operation)
+	_, err = bt.GetOpenConditionalOrdersWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestConditionalOrdersService_GetClosedConditionalOrders(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/conditional-orders/closed", r.URL.Path)
+		assert.Equal(t, "endDate=2021-11-01T00%3A00%3A00Z&nextPageToken=conditional", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"conditional","status":"FAILED","orderCreationErrorCode":"INSUFFICIENT_FUNDS","closedAt":"2021-10-02T00:00:00Z"}]`))
+	})
+	conditionalOrders, err := bt.GetClosedConditionalOrdersWithOpts(&GetClosedConditionalOrdersOpts{PageOpts: PageOpts{NextPageToken: "conditional", EndDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)}})
+	assert.NoError(t, err)
+	assert.Equal(t, CONDITIONALORDERSTATUS_FAILED, conditionalOrders[0].Status)
+	assert.Equal(t, "INSUFFICIENT_FUNDS", conditionalOrders[0].OrderCreationErrorCode)
+	_, err = bt.GetClosedConditionalOrdersWithOpts(nil)
+	assert.Error(t, err)
+}
+
+// Executions
+
+func TestExecutionsService_GetExecution(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/executions/execution%2F1", r.URL.EscapedPath())
+		_, _ = w.Write([]byte(`{"id":"execution","marketSymbol":"ETH-USD","executedAt":"2021-10-01T00:00:00Z","quantity":"0.5","rate":"3000","orderId":"order","commission":"1.5","isTaker":true}`))
+	})
+	execution, err := bt.GetExecution("execution/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "execution", execution.ID)
+	assert.Equal(t, "order", execution.OrderID)
+	assert.Equal(t, "0.5", execution.Quantity.String())
+	assert.Equal(t, "1.5", execution.Commission.String())
+	assert.True(t, execution.IsTaker)
+	assert.Equal(t, time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), execution.ExecutedAt)
+}
+
+func TestExecutionsService_GetExecutions(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/executions", r.URL.Path)
+		assert.Equal(t, "marketSymbol=ETH-USD&pageSize=200&previousPageToken=execution&startDate=2021-10-01T00%3A00%3A00Z", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"execution","marketSymbol":"ETH-USD","executedAt":"2021-10-01T00:00:00Z","quantity":"0.5","rate":"3000","orderId":"order","commission":"1.5","isTaker":true}]`))
+	})
+	executions, err := bt.GetExecutionsWithOpts(&GetExecutionsOpts{MarketSymbol: "eth-usd", PageOpts: PageOpts{PageSize: 200, PreviousPageToken: "execution", StartDate: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)}})
+	assert.NoError(t, err)
+	assert.Len(t, executions, 1)
+	assert.Equal(t, "3000", executions[0].Rate.String())
+	_, err = bt.GetExecutionsWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestExecutionsService_GetExecutionsLastID(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/executions/last-id", r.URL.Path)
+		_, _ = w.Write([]byte(`{"lastId":"execution"}`))
+	})
+	lastID, err := bt.GetExecutionsLastID()
+	assert.NoError(t, err)
+	assert.Equal(t, "execution", lastID)
+}
+
+func TestExecutionsService_GetOrderExecutions(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/orders/order/executions", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"id":"execution","marketSymbol":"ETH-USD","executedAt":"2021-10-01T00:00:00Z","quantity":"0.5","rate":"3000","orderId":"order","commission":"1.5","isTaker":true}]`))
+	})
+	executions, err := bt.GetOrderExecutions("order")
+	assert.NoError(t, err)
+	assert.Equal(t, "ETH-USD", executions[0].MarketSymbol)
+}
+
+func TestPageOpts_Encode(t *testing.T) {
+	q := url.Values{}
+	PageOpts{
+		NextPageToken: "id",
+		PageSize:      50,
+		StartDate:     time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+	}.encode(q)
+	assert.Equal(t, "nextPageToken=id&pageSize=50&startDate=2021-10-01T00%3A00%3A00Z", q.Encode())
+}
+
+// Balances
+
+func TestBalancesService_GetBalances(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetBalances()
+	assert.Error(t, err)
+}
+
+func TestBalancesService_GetBalancesLocal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/balances", r.URL.Path)
+		w.Header().Set("Sequence", "42")
+		if r.Method == "HEAD" {
+			return
+		}
+		_, _ = w.Write([]byte(`[{"currencySymbol":"BTC","total":"1.5","available":"1","updatedAt":"2021-10-01T00:00:00Z"}]`))
+	})
+	balances, err := bt.GetBalances()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, balances[0].Sequence)
+	assert.Equal(t, "BTC", balances[0].CurrencySymbol)
+	assert.Equal(t, "1.5", balances[0].Total.String())
+	sequence, err := bt.GetBalancesSequence()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, sequence)
+	_, meta, err := bt.GetBalancesWithMeta()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, meta.Sequence)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+}
+
+func TestBalancesService_GetBalance(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetBalance("BTC")
+	assert.Error(t, err)
+}
+
+func TestBalancesService_GetBalanceLocal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/balances/BTC", r.URL.Path)
+		w.Header().Set("Sequence", "42")
+		_, _ = w.Write([]byte(`{"currencySymbol":"BTC","total":"1.5","available":"1","updatedAt":"2021-10-01T00:00:00Z"}`))
+	})
+	balance, err := bt.GetBalance("btc")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", balance.Available.String())
+	assert.Equal(t, 42, balance.Sequence)
+}
+
+func TestBalancesService_GetBalancesSequence(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetBalancesSequence()
+	assert.Error(t, err)
+}
+
+// Account
+
+func TestAccountService_GetAccount(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/account", r.URL.Path)
+		_, _ = w.Write([]byte(`{"subaccountId":"subaccount","accountId":"account","actionsNeeded":["VERIFY_EMAIL"]}`))
+	})
+	account, err := bt.GetAccount()
+	assert.NoError(t, err)
+	assert.Equal(t, Account{SubaccountID: "subaccount", AccountID: "account", ActionsNeeded: []string{"VERIFY_EMAIL"}}, account)
+}
+
+func TestAccountService_GetAccountVolume(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/account/volume", r.URL.Path)
+		_, _ = w.Write([]byte(`{"updated":"2021-10-01T00:00:00Z","volume30days":"12345.67"}`))
+	})
+	volume, err := bt.GetAccountVolume()
+	assert.NoError(t, err)
+	assert.Equal(t, "12345.67", volume.Volume30days.String())
+	assert.Equal(t, time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), volume.Updated)
+}
+
+func TestAccountService_GetTradingFees(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/account/fees/trading":
+			_, _ = w.Write([]byte(`[{"marketSymbol":"ETH-USD","makerRate":"0.0035","takerRate":"0.0035"},{"marketSymbol":"BTC-USD","makerRate":"0.001","takerRate":"0.002"}]`))
+		case "/v3/account/fees/trading/ETH-USD":
+			_, _ = w.Write([]byte(`{"marketSymbol":"ETH-USD","makerRate":"0.0035","takerRate":"0.0035"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	fees, err := bt.GetTradingFees()
+	assert.NoError(t, err)
+	assert.Len(t, fees, 2)
+	assert.Equal(t, "0.002", fees[1].TakerRate.String())
+	fee, err := bt.GetTradingFee("eth-usd")
+	assert.NoError(t, err)
+	assert.Equal(t, "ETH-USD", fee.MarketSymbol)
+	assert.Equal(t, "0.0035", fee.MakerRate.String())
+}
+
+func TestAccountService_GetMarketsPermissions(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/account/permissions/markets", "/v3/account/permissions/markets/ETH-USD":
+			_, _ = w.Write([]byte(`[{"symbol":"ETH-USD","view":true,"buy":true,"sell":false}]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	policies, err := bt.GetMarketsPermissions()
+	assert.NoError(t, err)
+	assert.Equal(t, []MarketPolicy{{Symbol: "ETH-USD", View: true, Buy: true}}, policies)
+	policies, err = bt.GetMarketPermissions("eth-usd")
+	assert.NoError(t, err)
+	assert.False(t, policies[0].Sell)
+}
+
+func TestAccountService_GetCurrenciesPermissions(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/account/permissions/currencies", "/v3/account/permissions/currencies/ETH":
+			_, _ = w.Write([]byte(`[{"symbol":"ETH","view":true,"deposit":{"blockchain":true,"creditCard":false,"wireTransfer":false,"ach":false},"withdraw":{"blockchain":true,"wireTransfer":false,"ach":true}}]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	policies, err := bt.GetCurrenciesPermissions()
+	assert.NoError(t, err)
+	assert.Equal(t, "ETH", policies[0].Symbol)
+	assert.True(t, policies[0].Deposit.Blockchain)
+	assert.False(t, policies[0].Deposit.CreditCard)
+	assert.True(t, policies[0].Withdraw.Ach)
+	policies, err = bt.GetCurrencyPermissions("eth")
+	assert.NoError(t, err)
+	assert.True(t, policies[0].View)
+}
+
+// Addresses
+
+func TestAddressesService_GetAddresses(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/addresses", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"status":"PROVISIONED","currencySymbol":"XRP","cryptoAddress":"address","cryptoAddressTag":"tag"}]`))
+	})
+	addresses, err := bt.GetAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{{Status: ADDRESSSTATUS_PROVISIONED, CurrencySymbol: "XRP", CryptoAddress: "address", CryptoAddressTag: "tag"}}, addresses)
+}
+
+func TestAddressesService_GetAddress(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/addresses/BTC", r.URL.Path)
+		_, _ = w.Write([]byte(`{"status":"REQUESTED","currencySymbol":"BTC"}`))
+	})
+	address, err := bt.GetAddress("btc")
+	assert.NoError(t, err)
+	assert.Equal(t, ADDRESSSTATUS_REQUESTED, address.Status)
+	assert.Empty(t, address.CryptoAddress)
+}
+
+func TestAddressesService_CreateAddress(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v3/addresses", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"currencySymbol":"BTC"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"status":"REQUESTED","currencySymbol":"BTC"}`))
+	})
+	address, err := bt.CreateAddress("btc")
+	assert.NoError(t, err)
+	assert.Equal(t, "BTC", address.CurrencySymbol)
+	assert.Equal(t, ADDRESSSTATUS_REQUESTED, address.Status)
+}
+
+// Deposits
+
+func TestDepositsService_GetOpenDeposits(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v3/deposits/open", r.URL.Path)
+		assert.Equal(t, "currencySymbol=BTC&status=PENDING", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"deposit","currencySymbol":"BTC","quantity":"0.5","cryptoAddress":"address","txId":"tx","confirmations":2,"updatedAt":"2021-10-01T00:00:00Z","status":"PENDING","source":"BLOCKCHAIN"}]`))
+	})
+	deposits, err := bt.GetOpenDepositsWithOpts(&GetOpenDepositsOpts{Status: "pending", CurrencySymbol: "btc"})
+	assert.NoError(t, err)
+	assert.Len(t, deposits, 1)
+	assert.Equal(t, "deposit", deposits[0].ID)
+	assert.Equal(t, "0.5", deposits[0].Quantity.String())
+	assert.Equal(t, 2, deposits[0].Confirmations)
+	assert.Equal(t, DEPOSITSTATUS_PENDING, deposits[0].Status)
+	assert.Equal(t, time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), deposits[0].UpdatedAt)
+	assert.Nil(t, deposits[0].Error)
+	_, err = bt.GetOpenDepositsWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestDepositsService_GetClosedDeposits(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/deposits/closed", r.URL.Path)
+		assert.Equal(t, "endDate=2021-11-01T00%3A00%3A00Z&pageSize=10&startDate=2021-10-01T00%3A00%3A00Z&status=INVALIDATED", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"deposit","currencySymbol":"BTC","quantity":"1","status":"INVALIDATED","completedAt":"2021-10-02T00:00:00Z","error":{"code":"DEPOSIT_INVALIDATED","detail":"invalid"}}]`))
+	})
+	deposits, err := bt.GetClosedDepositsWithOpts(&GetClosedDepositsOpts{
+		Status:   DEPOSITSTATUS_INVALIDATED,
+		PageOpts: PageOpts{PageSize: 10, StartDate: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC), deposits[0].CompletedAt)
+	assert.Equal(t, "DEPOSIT_INVALIDATED", deposits[0].Error.Code)
+	_, err = bt.GetClosedDepositsWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestDepositsService_GetDepositsByTxID(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/deposits/ByTxId/0x0%2F1", r.URL.EscapedPath())
+		_, _ = w.Write([]byte(`[{"id":"deposit","txId":"0x0/1"}]`))
+	})
+	deposits, err := bt.GetDepositsByTxID("0x0/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x0/1", deposits[0].TxID)
+}
+
+// Withdrawals
+
+func TestWithdrawalsService_NewWithdrawalJSON(t *testing.T) {
+	newWithdrawal := NewWithdrawal{CurrencySymbol: "BTC", CryptoAddress: "address", WithdrawEntireBalance: true}
+	payload, err := json.Marshal(newWithdrawal)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"currencySymbol":"BTC","cryptoAddress":"address","withdrawEntireBalance":true}`, string(payload))
+}
+
+func TestWithdrawalsService_CreateWithdrawal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v3/withdrawals", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"currencySymbol":"BTC","quantity":"1","cryptoAddress":"address","clientWithdrawalId":"client"}`, string(body))
+		_, _ = w.Write([]byte(`{"id":"withdrawal","currencySymbol":"BTC","quantity":"1","cryptoAddress":"address","txCost":"0.0005","status":"REQUESTED","createdAt":"2021-10-01T00:00:00Z","clientWithdrawalId":"client"}`))
+	})
+	withdrawal, err := bt.CreateWithdrawal(NewWithdrawal{CurrencySymbol: "BTC", Quantity: decimal.NewFromInt(1), CryptoAddress: "address", ClientWithdrawalID: "client"})
+	assert.NoError(t, err)
+	assert.Equal(t, "withdrawal", withdrawal.ID)
+	assert.Equal(t, "0.0005", withdrawal.TxCost.String())
+	assert.Equal(t, WITHDRAWALSTATUS_REQUESTED, withdrawal.Status)
+	assert.Equal(t, "client", withdrawal.ClientWithdrawalID)
+}
+
+func TestWithdrawalsService_GetClosedWithdrawals(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/withdrawals/closed", r.URL.Path)
+		assert.Equal(t, "currencySymbol=ETH&nextPageToken=withdrawal&status=CANCELLED", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[{"id":"withdrawal","currencySymbol":"ETH","quantity":"2","status":"CANCELLED","completedAt":"2021-10-02T00:00:00Z"}]`))
+	})
+	withdrawals, err := bt.GetClosedWithdrawalsWithOpts(&GetClosedWithdrawalsOpts{Status: WITHDRAWALSTATUS_CANCELLED, CurrencySymbol: "eth", PageOpts: PageOpts{NextPageToken: "withdrawal"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", withdrawals[0].Quantity.String())
+	assert.Equal(t, time.Date(2021, 10, 2, 0, 0, 0, 0, time.UTC), withdrawals[0].CompletedAt)
+	_, err = bt.GetClosedWithdrawalsWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestWithdrawalsService_CancelWithdrawal(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v3/withdrawals/withdrawal", r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":"withdrawal","status":"CANCELLED"}`))
+	})
+	withdrawal, err := bt.CancelWithdrawal("withdrawal")
+	assert.NoError(t, err)
+	assert.Equal(t, WITHDRAWALSTATUS_CANCELLED, withdrawal.Status)
+}
+
+func TestWithdrawalsService_GetAllowedAddresses(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/withdrawals/allowed-addresses", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"currencySymbol":"BTC","createdAt":"2021-10-01T00:00:00Z","status":"ACTIVE","activeAt":"2021-10-03T00:00:00Z","cryptoAddress":"address"}]`))
+	})
+	addresses, err := bt.GetAllowedAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, "address", addresses[0].CryptoAddress)
+	assert.Equal(t, time.Date(2021, 10, 3, 0, 0, 0, 0, time.UTC), addresses[0].ActiveAt)
+}
+
+// Subaccounts
+
+func TestSubaccountsService_GetSubaccounts(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetSubaccounts()
+	assert.Error(t, err)
+}
+
+func TestSubaccountsService_WithSubaccount(t *testing.T) {
+	bt := New("key", "secret")
+	sub := bt.WithSubaccount("subaccount")
+	assert.Equal(t, "subaccount", sub.client.subaccountID)
+	assert.Empty(t, bt.client.subaccountID)
+}
+
+// Transfers
+
+func TestTransfersService_CreateTransfer(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.CreateTransfer(NewTransfer{ToSubaccountID: "subaccount", CurrencySymbol: "BTC", Amount: decimal.NewFromInt(1)})
+	assert.Error(t, err)
+}
+
+func TestTransfersService_GetSentTransfers(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetSentTransfersWithOpts(&GetSentTransfersOpts{ToMasterAccount: true})
+	assert.Error(t, err)
+	_, err = bt.GetSentTransfersWithOpts(nil)
+	assert.Error(t, err)
+}
+
+func TestTransfersService_GetReceivedTransfers(t *testing.T) {
+	bt := New("", "")
+	_, err := bt.GetReceivedTransfers()
+	assert.Error(t, err)
+	_, err = bt.GetReceivedTransfersWithOpts(nil)
+	assert.Error(t, err)
+}
+
 func TestPingService_Ping(t *testing.T) {
 	bt := New("", "")
 	ping, err := bt.Ping()
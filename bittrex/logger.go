@@ -0,0 +1,56 @@
+package bittrex
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Logger receives the diagnostics of the library, it is satisfied by *slog.Logger from Go 1.21.
+//
+//	args are alternating keys and values, e.g. "stream", "orderBook", "market", "ETH-USD", "sequence", 42.
+//	Debug messages are only emitted when debug is enabled on the client.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// stdLogger is the default logger, writing through the standard log package
+type stdLogger struct{}
+
+func (stdLogger) Debug(msg string, args ...interface{}) { stdLog("DEBUG", msg, args) }
+func (stdLogger) Info(msg string, args ...interface{})  { stdLog("INFO", msg, args) }
+func (stdLogger) Warn(msg string, args ...interface{})  { stdLog("WARN", msg, args) }
+func (stdLogger) Error(msg string, args ...interface{}) { stdLog("ERROR", msg, args) }
+
+func stdLog(level string, msg string, args []interface{}) {
+	log.Print(formatLog(level, msg, args))
+}
+
+// formatLog formats a message as level=LEVEL msg="message" key=value...
+func formatLog(level string, msg string, args []interface{}) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "level=%s msg=%q", level, msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			fmt.Fprintf(&sb, " !BADKEY=%v", args[i])
+			break
+		}
+		value := fmt.Sprint(args[i+1])
+		if strings.ContainsAny(value, " \"=\n") {
+			value = fmt.Sprintf("%q", value)
+		}
+		fmt.Fprintf(&sb, " %v=%s", args[i], value)
+	}
+	return sb.String()
+}
+
+// nopLogger discards every message
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
@@ -0,0 +1,57 @@
+package bittrex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLogger records the messages it receives
+type testLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *testLogger) log(level string, msg string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, level+" "+msg+" "+fmt.Sprint(args...))
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("ERROR", msg, args) }
+
+func TestLogger_FormatLog(t *testing.T) {
+	assert.Equal(t, `level=WARN msg="decode error" stream=orderBook sequence=42 error="illegal base64 data"`,
+		formatLog("WARN", "decode error", []interface{}{"stream", "orderBook", "sequence", 42, "error", errors.New("illegal base64 data")}))
+	assert.Equal(t, `level=INFO msg="ok" !BADKEY=market`, formatLog("INFO", "ok", []interface{}{"market"}))
+}
+
+func TestLogger_Debug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	bt, err := NewWithOptions(WithLogger(logger))
+	assert.NoError(t, err)
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.NoError(t, err)
+	assert.Empty(t, logger.messages)
+
+	bt.SetDebug(true)
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.NoError(t, err)
+	assert.Len(t, logger.messages, 2)
+	assert.Contains(t, logger.messages[0], "DEBUG request")
+	assert.Contains(t, logger.messages[1], "DEBUG response")
+}
@@ -0,0 +1,36 @@
+package bittrex
+
+import "time"
+
+// Metrics collects counters and observations about the activity of the library.
+//
+//	The methods map to Prometheus counters and histograms, e.g. ObserveRequest to a histogram labeled by method, endpoint and status code.
+//	Embed NopMetrics to implement only some of them.
+type Metrics interface {
+	// ObserveRequest is called after each http request attempt, statusCode is 0 if no response was received
+	ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration)
+	// IncRetries is called when a failed http request is retried
+	IncRetries(method string, endpoint string)
+	// ObserveRateLimitWait is called with the time a request was queued by the rate limiter
+	ObserveRateLimitWait(authenticated bool, wait time.Duration)
+	// IncReconnects is called when a websocket connection is re-established
+	IncReconnects()
+	// IncMessages is called for each message received on a websocket stream
+	IncMessages(stream string)
+	// IncDecodeErrors is called when a websocket message cannot be decoded
+	IncDecodeErrors(stream string)
+	// IncDroppedMessages is called when a websocket message is discarded because the consumer channel is full
+	IncDroppedMessages(stream string)
+}
+
+// NopMetrics discards every metric
+type NopMetrics struct{}
+
+func (NopMetrics) ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration) {
+}
+func (NopMetrics) IncRetries(method string, endpoint string)                   {}
+func (NopMetrics) ObserveRateLimitWait(authenticated bool, wait time.Duration) {}
+func (NopMetrics) IncReconnects()                                              {}
+func (NopMetrics) IncMessages(stream string)                                   {}
+func (NopMetrics) IncDecodeErrors(stream string)                               {}
+func (NopMetrics) IncDroppedMessages(stream string)                            {}
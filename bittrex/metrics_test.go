@@ -0,0 +1,118 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testMetrics counts the metrics it receives
+type testMetrics struct {
+	NopMetrics
+	mu       sync.Mutex
+	requests []string
+	retries  int
+	waits    int
+	messages map[string]int
+	errors   map[string]int
+	dropped  map[string]int
+}
+
+func newTestMetrics() *testMetrics {
+	return &testMetrics{messages: map[string]int{}, errors: map[string]int{}, dropped: map[string]int{}}
+}
+
+func (m *testMetrics) ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, method+" "+endpoint+" "+http.StatusText(statusCode))
+}
+
+func (m *testMetrics) IncRetries(method string, endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries++
+}
+
+func (m *testMetrics) ObserveRateLimitWait(authenticated bool, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.waits++
+}
+
+func (m *testMetrics) IncMessages(stream string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages[stream]++
+}
+
+func (m *testMetrics) IncDecodeErrors(stream string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.errors[stream]++
+}
+
+func (m *testMetrics) IncDroppedMessages(stream string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dropped[stream]++
+}
+
+func TestMetrics_Requests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	metrics := newTestMetrics()
+	bt, err := NewWithOptions(
+		WithAPIBase(server.URL),
+		WithMetrics(metrics),
+		WithRateLimiter(NewDefaultRateLimiter()),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+	assert.NoError(t, err)
+	_, err = bt.GetTrades("ETH-USD")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET markets/{marketSymbol}/trades Bad Gateway", "GET markets/{marketSymbol}/trades OK"}, metrics.requests)
+	assert.Equal(t, 1, metrics.retries)
+	assert.Equal(t, 2, metrics.waits)
+}
+
+func TestMetrics_StreamMessages(t *testing.T) {
+	metrics := newTestMetrics()
+	bt := New("", "")
+	bt.SetMetrics(metrics)
+	bt.SetLogger(nil)
+
+	// Nobody receives from the unbuffered channel, so the ticker is dropped
+	tickers := make(chan Ticker)
+	s := bt.NewStreamClient()
+	assert.NoError(t, s.SubscribeTicker("ETH-USD", tickers))
+	s.route(STREAM_TICKER, []json.RawMessage{
+		encodeMessage(`{"symbol":"ETH-USD","lastTradeRate":"1500"}`),
+		json.RawMessage(`"!"`),
+		encodeMessage(`[]`),
+	})
+	assert.Equal(t, 3, metrics.messages[STREAM_TICKER])
+	assert.Equal(t, 2, metrics.errors[STREAM_TICKER])
+	assert.Equal(t, 1, metrics.dropped[STREAM_TICKER])
+
+	assert.NoError(t, s.Subscribe(TickerChannel("BTC-USD"), func(out []byte) error {
+		return errors.New("unmarshal error")
+	}))
+	s.route(STREAM_TICKER, []json.RawMessage{encodeMessage(`{"symbol":"BTC-USD"}`)})
+	assert.Equal(t, 3, metrics.errors[STREAM_TICKER])
+}
@@ -0,0 +1,166 @@
+package bittrex
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Option configures a client created with NewWithOptions or NewClientWithOptions
+type Option func(c *Client) error
+
+// WithCredentials set the API key and secret used to sign authenticated requests
+func WithCredentials(apiKey, apiSecret string) Option {
+	return func(c *Client) error {
+		c.apiKey = apiKey
+		c.apiSecret = apiSecret
+		return nil
+	}
+}
+
+// WithSubaccountID set the subaccount on behalf of which authenticated requests are made
+func WithSubaccountID(subaccountID string) Option {
+	return func(c *Client) error {
+		c.subaccountID = subaccountID
+		return nil
+	}
+}
+
+// WithHTTPClient set the http client used to send requests.
+//
+//	Unless WithTimeout is given, its Timeout (or 30 seconds if unset) is used as the request timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("invalid http client pointer")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTimeout set the timeout of each request attempt, 1 second by default (or the one of the http client)
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.httpTimeout = timeout
+		return nil
+	}
+}
+
+// WithAPIBase set the base URL of the HTTP API, API_BASE by default
+func WithAPIBase(apiBase string) Option {
+	return func(c *Client) error {
+		return c.setAPIBase(apiBase)
+	}
+}
+
+// WithSocketBase set the endpoint of the websocket API, "https://socket-v3.bittrex.com" by default
+func WithSocketBase(socketBase string) Option {
+	return func(c *Client) error {
+		return c.setSocketBase(socketBase)
+	}
+}
+
+// WithWebsocketDialer set the dialer of the websocket connections, e.g. to go through a proxy, websocket.DefaultDialer by default
+func WithWebsocketDialer(dialer *websocket.Dialer) Option {
+	return func(c *Client) error {
+		if dialer == nil {
+			return errors.New("invalid websocket dialer pointer")
+		}
+		c.wsDialer = dialer
+		return nil
+	}
+}
+
+// WithRateLimiter set the rate limiter queuing requests to stay within the API quotas
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) error {
+		c.limiter = limiter
+		return nil
+	}
+}
+
+// WithRetryPolicy set the policy used to retry failed idempotent requests
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		c.retry = policy
+		return nil
+	}
+}
+
+// WithClock set the function giving the current time used to timestamp signed requests, time.Now by default.
+//
+//	Useful to compensate a drift between the local clock and the server clock.
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) error {
+		if now == nil {
+			return errors.New("invalid clock")
+		}
+		c.now = now
+		return nil
+	}
+}
+
+// WithUserAgent set the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithLogger set the logger receiving the diagnostics of the library (nil to discard them), the standard log package by default
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithRequestHook registers a hook called before each http request attempt
+func WithRequestHook(hook RequestHook) Option {
+	return func(c *Client) error {
+		c.requestHooks = append(c.requestHooks, hook)
+		return nil
+	}
+}
+
+// WithResponseHook registers a hook called after each http request attempt
+func WithResponseHook(hook ResponseHook) Option {
+	return func(c *Client) error {
+		c.responseHooks = append(c.responseHooks, hook)
+		return nil
+	}
+}
+
+// WithMetrics set the collector of the metrics of the library (nil to discard them)
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) error {
+		if metrics == nil {
+			metrics = NopMetrics{}
+		}
+		c.metrics = metrics
+		return nil
+	}
+}
+
+// WithGapEvents set the channel on which sequence gaps of the websocket streams are reported, see Bittrex.SetGapEvents
+func WithGapEvents(events chan<- SequenceGap) Option {
+	return func(c *Client) error {
+		c.gapEvents = events
+		return nil
+	}
+}
+
+// WithDebug enable/disable debug messages, including a log of each http request/response with the credentials redacted
+func WithDebug(enable bool) Option {
+	return func(c *Client) error {
+		c.debug = enable
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package bittrex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/balances", r.URL.Path)
+		assert.Equal(t, "go-bittrex-test", r.Header.Get("User-Agent"))
+		assert.Equal(t, "1633046400000", r.Header.Get("Api-Timestamp"))
+		assert.Equal(t, "subaccount", r.Header.Get("Api-Subaccount-Id"))
+		assert.Equal(t, sign("secret", r, server.URL+r.URL.RequestURI()), r.Header.Get("Api-Signature"))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	bt, err := NewWithOptions(
+		WithCredentials("key", "secret"),
+		WithSubaccountID("subaccount"),
+		WithAPIBase(server.URL),
+		WithClock(func() time.Time { return now }),
+		WithUserAgent("go-bittrex-test"),
+		WithRetryPolicy(&DefaultRetryPolicy),
+		WithRateLimiter(NewDefaultRateLimiter()),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1*time.Second, bt.client.httpTimeout)
+	_, err = bt.GetBalances()
+	assert.NoError(t, err)
+}
+
+func TestNewWithOptions_Timeout(t *testing.T) {
+	bt, err := NewWithOptions(WithHTTPClient(&http.Client{Timeout: 5 * time.Second}))
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, bt.client.httpTimeout)
+	bt, err = NewWithOptions(WithTimeout(time.Minute), WithHTTPClient(&http.Client{}))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, bt.client.httpTimeout)
+	assert.Equal(t, 30*time.Second, NewWithCustomHTTPClient("", "", &http.Client{}).client.httpTimeout)
+}
+
+func TestNewWithOptions_Invalid(t *testing.T) {
+	_, err := NewWithOptions(WithAPIBase("localhost"))
+	assert.Error(t, err)
+	_, err = NewWithOptions(WithHTTPClient(nil))
+	assert.Error(t, err)
+	_, err = NewClientWithOptions(WithClock(nil))
+	assert.Error(t, err)
+	_, err = NewWithOptions(WithWebsocketDialer(nil))
+	assert.Error(t, err)
+
+	// The wrappers fail at construction rather than at the first request
+	assert.Panics(t, func() { NewClientWithCustomHTTPConfig("", "", nil) })
+	assert.Panics(t, func() { NewWithCustomHTTPClient("", "", nil) })
+}
+
+func TestNewWithOptions_Defaults(t *testing.T) {
+	bt, err := NewWithOptions()
+	assert.NoError(t, err)
+	assert.Equal(t, API_BASE, bt.client.apiBase)
+	_, err = bt.client.do(context.Background(), "GET", "balances", "", true)
+	assert.Error(t, err)
+}
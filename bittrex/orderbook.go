@@ -0,0 +1,263 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LocalOrderBook maintains the order book of a market from a REST snapshot and the deltas of its websocket channel.
+//
+//	It follows the procedure documented by Bittrex: deltas are buffered while the snapshot is fetched,
+//	those older than the Sequence header of the snapshot are discarded and the others applied in order.
+//	When a delta is missed the book is marked as unsynced and a new snapshot is fetched,
+//	failed or outdated snapshots are fetched again after a backoff.
+//	All its methods are safe for concurrent use.
+type LocalOrderBook struct {
+	s            *StreamClient
+	marketSymbol string
+	depth        int
+	backoff      RetryPolicy // Delays between snapshot attempts
+
+	mu       sync.RWMutex
+	synced   bool
+	fetching bool        // A snapshot is being fetched or scheduled
+	retry    *time.Timer // Schedules the next snapshot attempt
+	closed   bool
+	sequence int
+	bids     map[string]OrderBookEntry
+	asks     map[string]OrderBookEntry
+	buffer   []OrderBookSlice
+}
+
+// maxBufferedDeltas is the number of deltas a LocalOrderBook keeps while it is not synced, the oldest ones are discarded beyond it
+const maxBufferedDeltas = 1000
+
+// SubscribeLocalOrderBook subscribes to the order book channel of a market and returns the book maintained from it.
+//
+//	The depth must be 1, 25 or 500. The book is synced shortly after the first delta is received once connected.
+func (s *StreamClient) SubscribeLocalOrderBook(marketSymbol string, depth int) (book *LocalOrderBook, err error) {
+	if depth != 1 && depth != 25 && depth != 500 {
+		return nil, errors.New("invalid depth")
+	}
+
+	book = &LocalOrderBook{
+		s:            s,
+		marketSymbol: strings.ToUpper(marketSymbol),
+		depth:        depth,
+		backoff:      RetryPolicy{InitialBackoff: 500 * time.Millisecond, MaxBackoff: 30 * time.Second},
+		bids:         map[string]OrderBookEntry{},
+		asks:         map[string]OrderBookEntry{},
+	}
+	if err = s.Subscribe(OrderbookChannel(marketSymbol, depth), book.handle); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// handle applies a delta received on the order book channel, or buffers it until the book is synced
+func (o *LocalOrderBook) handle(data []byte) error {
+	var delta OrderBookSlice
+	if err := json.Unmarshal(data, &delta); err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil
+	}
+	if o.synced {
+		if delta.Sequence <= o.sequence {
+			return nil
+		}
+		if delta.Sequence == o.sequence+1 {
+			o.apply(delta)
+			return nil
+		}
+		o.s.b.client.logger.Warn("order book out of sync", "market", o.marketSymbol, "sequence", delta.Sequence, "expected", o.sequence+1)
+		o.synced = false
+		o.buffer = nil
+	}
+
+	if len(o.buffer) >= maxBufferedDeltas {
+		o.buffer = append(o.buffer[:0], o.buffer[len(o.buffer)-maxBufferedDeltas+1:]...)
+	}
+	o.buffer = append(o.buffer, delta)
+	if !o.fetching {
+		o.fetching = true
+		go o.resync(1)
+	}
+	return nil
+}
+
+// resync fetches a snapshot and applies the buffered deltas which follow it, scheduling another attempt if it fails
+func (o *LocalOrderBook) resync(attempt int) {
+	snapshot, meta, err := o.s.b.GetOrderBookWithMeta(o.marketSymbol, &GetOrderBookOpts{Depth: o.depth})
+	var sequence int
+	if err == nil {
+		sequence, err = parseSequence(meta.Header)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return
+	}
+	if err != nil {
+		o.s.b.client.logger.Warn("order book snapshot error", "market", o.marketSymbol, "attempt", attempt, "error", err)
+		o.schedule(attempt)
+		return
+	}
+
+	buffer := o.buffer[:0]
+	for _, delta := range o.buffer {
+		if delta.Sequence > sequence {
+			buffer = append(buffer, delta)
+		}
+	}
+	o.buffer = buffer
+	if len(buffer) > 0 && buffer[0].Sequence != sequence+1 {
+		// The snapshot is older than the buffered deltas
+		o.s.b.client.logDebug("order book snapshot too old", "market", o.marketSymbol, "sequence", sequence, "buffered", buffer[0].Sequence)
+		o.schedule(attempt)
+		return
+	}
+
+	o.bids = map[string]OrderBookEntry{}
+	o.asks = map[string]OrderBookEntry{}
+	o.apply(OrderBookSlice{BidDeltas: snapshot.Bid, AskDeltas: snapshot.Ask, Sequence: sequence})
+	for i, delta := range buffer {
+		if delta.Sequence != o.sequence+1 {
+			o.s.b.client.logDebug("order book buffer out of sync", "market", o.marketSymbol, "sequence", delta.Sequence, "expected", o.sequence+1)
+			o.buffer = append(o.buffer[:0], buffer[i:]...)
+			o.schedule(attempt)
+			return
+		}
+		o.apply(delta)
+	}
+	o.buffer = nil
+	o.synced = true
+	o.fetching = false
+	o.s.b.client.logDebug("order book synced", "market", o.marketSymbol, "sequence", o.sequence)
+}
+
+// schedule fetches a snapshot again after the backoff of the given failed attempt, o.mu must be held
+func (o *LocalOrderBook) schedule(attempt int) {
+	o.retry = time.AfterFunc(o.backoff.backoff(attempt), func() {
+		o.resync(attempt + 1)
+	})
+}
+
+// apply updates the levels of the book with a delta, a zero quantity removes the level, o.mu must be held
+func (o *LocalOrderBook) apply(delta OrderBookSlice) {
+	update := func(levels map[string]OrderBookEntry, entries []OrderBookEntry) {
+		for _, entry := range entries {
+			if entry.Quantity.IsZero() {
+				delete(levels, entry.Rate.String())
+			} else {
+				levels[entry.Rate.String()] = entry
+			}
+		}
+	}
+	update(o.bids, delta.BidDeltas)
+	update(o.asks, delta.AskDeltas)
+	o.sequence = delta.Sequence
+}
+
+// Close unsubscribes from the order book channel and stops fetching snapshots, the book is no longer synced.
+func (o *LocalOrderBook) Close() error {
+	o.mu.Lock()
+	o.closed = true
+	o.synced = false
+	o.buffer = nil
+	if o.retry != nil {
+		o.retry.Stop()
+	}
+	o.mu.Unlock()
+	return o.s.Unsubscribe(OrderbookChannel(o.marketSymbol, o.depth))
+}
+
+// Synced reports whether the book reflects the deltas received so far.
+func (o *LocalOrderBook) Synced() bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.synced
+}
+
+// Sequence returns the sequence of the last delta applied to the book.
+func (o *LocalOrderBook) Sequence() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.sequence
+}
+
+// BestBid returns the highest bid, ok is false if the book is not synced or has no bid.
+func (o *LocalOrderBook) BestBid() (entry OrderBookEntry, ok bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if !o.synced {
+		return
+	}
+	return best(o.bids, func(a, b decimal.Decimal) bool { return a.GreaterThan(b) })
+}
+
+// BestAsk returns the lowest ask, ok is false if the book is not synced or has no ask.
+func (o *LocalOrderBook) BestAsk() (entry OrderBookEntry, ok bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if !o.synced {
+		return
+	}
+	return best(o.asks, func(a, b decimal.Decimal) bool { return a.LessThan(b) })
+}
+
+// Depth returns the best levels of the book, at most levels on each side, ok is false if the book is not synced.
+func (o *LocalOrderBook) Depth(levels int) (orderBook OrderBook, ok bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if !o.synced {
+		return
+	}
+	orderBook = OrderBook{
+		Symbol:   o.marketSymbol,
+		Depth:    o.depth,
+		Bid:      sorted(o.bids, levels, func(a, b decimal.Decimal) bool { return a.GreaterThan(b) }),
+		Ask:      sorted(o.asks, levels, func(a, b decimal.Decimal) bool { return a.LessThan(b) }),
+		Sequence: o.sequence,
+	}
+	return orderBook, true
+}
+
+// Snapshot returns all the levels of the book, bids in descending and asks in ascending order, ok is false if the book is not synced.
+func (o *LocalOrderBook) Snapshot() (orderBook OrderBook, ok bool) {
+	return o.Depth(-1)
+}
+
+// best returns the entry of levels whose rate comes first
+func best(levels map[string]OrderBookEntry, before func(a, b decimal.Decimal) bool) (entry OrderBookEntry, ok bool) {
+	for _, level := range levels {
+		if !ok || before(level.Rate, entry.Rate) {
+			entry, ok = level, true
+		}
+	}
+	return
+}
+
+// sorted returns at most n entries of levels (all of them if n is negative), in order of their rate
+func sorted(levels map[string]OrderBookEntry, n int, before func(a, b decimal.Decimal) bool) []OrderBookEntry {
+	entries := make([]OrderBookEntry, 0, len(levels))
+	for _, level := range levels {
+		entries = append(entries, level)
+	}
+	sort.Slice(entries, func(i, j int) bool { return before(entries[i].Rate, entries[j].Rate) })
+	if n >= 0 && len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
@@ -0,0 +1,152 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalOrderBook_Sync(t *testing.T) {
+	var fetches int32
+	snapshots := []string{
+		`{"bid":[{"quantity":"1","rate":"100"},{"quantity":"2","rate":"99"}],"ask":[{"quantity":"1","rate":"101"},{"quantity":"3","rate":"102"}]}`,
+		`{"bid":[{"quantity":"4","rate":"98"}],"ask":[{"quantity":"2","rate":"103"}]}`,
+	}
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/markets/ETH-USD/orderbook", r.URL.Path)
+		assert.Equal(t, "25", r.URL.Query().Get("depth"))
+		n := atomic.AddInt32(&fetches, 1)
+		if n == 1 {
+			w.Header().Set("Sequence", "10")
+		} else {
+			w.Header().Set("Sequence", "13")
+		}
+		_, _ = w.Write([]byte(snapshots[n-1]))
+	})
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+
+	_, err := s.SubscribeLocalOrderBook("ETH-USD", 10)
+	assert.Error(t, err)
+	book, err := s.SubscribeLocalOrderBook("ETH-USD", 25)
+	assert.NoError(t, err)
+	_, ok := book.BestBid()
+	assert.False(t, ok)
+
+	delta := func(sequence int, deltas string) {
+		s.route(STREAM_ORDERBOOK, []json.RawMessage{encodeMessage(`{"marketSymbol":"ETH-USD","depth":25,"sequence":` + strconv.Itoa(sequence) + `,` + deltas + `}`)})
+	}
+	delta(9, `"bidDeltas":[{"quantity":"5","rate":"100"}],"askDeltas":[]`)
+	delta(11, `"bidDeltas":[{"quantity":"0","rate":"100"}],"askDeltas":[{"quantity":"5","rate":"101"},{"quantity":"1","rate":"104"}]`)
+	assert.Eventually(t, func() bool { return book.Synced() && book.Sequence() == 11 }, time.Second, time.Millisecond)
+
+	bid, ok := book.BestBid()
+	assert.True(t, ok)
+	assert.Equal(t, "99", bid.Rate.String())
+	assert.Equal(t, "2", bid.Quantity.String())
+	ask, ok := book.BestAsk()
+	assert.True(t, ok)
+	assert.Equal(t, "5", ask.Quantity.String())
+
+	orderBook, ok := book.Depth(2)
+	assert.True(t, ok)
+	assert.Len(t, orderBook.Bid, 1)
+	assert.Equal(t, []string{"101", "102"}, []string{orderBook.Ask[0].Rate.String(), orderBook.Ask[1].Rate.String()})
+	orderBook, _ = book.Snapshot()
+	assert.Len(t, orderBook.Ask, 3)
+	assert.Equal(t, 11, orderBook.Sequence)
+
+	// A missed delta triggers a new snapshot
+	delta(13, `"bidDeltas":[],"askDeltas":[]`)
+	assert.Eventually(t, func() bool { return book.Synced() && book.Sequence() == 13 }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+	bid, _ = book.BestBid()
+	assert.Equal(t, "98", bid.Rate.String())
+	delta(14, `"bidDeltas":[{"quantity":"1","rate":"99"}],"askDeltas":[]`)
+	bid, _ = book.BestBid()
+	assert.Equal(t, "99", bid.Rate.String())
+}
+
+func TestLocalOrderBook_RetrySnapshot(t *testing.T) {
+	var fetches int32
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&fetches, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"code":"SERVICE_UNAVAILABLE"}`))
+		case 2:
+			// Older than the buffered delta
+			w.Header().Set("Sequence", "5")
+			_, _ = w.Write([]byte(`{"bid":[],"ask":[]}`))
+		default:
+			w.Header().Set("Sequence", "10")
+			_, _ = w.Write([]byte(`{"bid":[{"quantity":"1","rate":"100"}],"ask":[]}`))
+		}
+	})
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+
+	// The symbol is upper-cased to match the deltas
+	book, err := s.SubscribeLocalOrderBook("eth-usd", 25)
+	assert.NoError(t, err)
+	book.backoff = RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	// No other delta is received, the snapshot is retried until the book is synced
+	s.route(STREAM_ORDERBOOK, []json.RawMessage{encodeMessage(`{"marketSymbol":"ETH-USD","depth":25,"sequence":10,"bidDeltas":[],"askDeltas":[]}`)})
+	assert.Eventually(t, book.Synced, time.Second, time.Millisecond)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&fetches))
+	assert.Equal(t, 10, book.Sequence())
+	orderBook, _ := book.Snapshot()
+	assert.Equal(t, "ETH-USD", orderBook.Symbol)
+}
+
+func TestLocalOrderBook_BufferLimit(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+
+	book, err := s.SubscribeLocalOrderBook("ETH-USD", 25)
+	assert.NoError(t, err)
+	book.backoff = RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	for sequence := 1; sequence <= maxBufferedDeltas+10; sequence++ {
+		s.route(STREAM_ORDERBOOK, []json.RawMessage{encodeMessage(`{"marketSymbol":"ETH-USD","depth":25,"sequence":` + strconv.Itoa(sequence) + `,"bidDeltas":[],"askDeltas":[]}`)})
+	}
+	book.mu.RLock()
+	assert.Len(t, book.buffer, maxBufferedDeltas)
+	assert.Equal(t, 11, book.buffer[0].Sequence)
+	book.mu.RUnlock()
+	assert.NoError(t, book.Close())
+}
+
+func TestLocalOrderBook_Close(t *testing.T) {
+	var fetches int32
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+
+	book, err := s.SubscribeLocalOrderBook("ETH-USD", 25)
+	assert.NoError(t, err)
+	book.backoff = RetryPolicy{InitialBackoff: 20 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}
+	s.route(STREAM_ORDERBOOK, []json.RawMessage{encodeMessage(`{"marketSymbol":"ETH-USD","depth":25,"sequence":1,"bidDeltas":[],"askDeltas":[]}`)})
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&fetches) >= 1 }, time.Second, time.Millisecond)
+
+	assert.NoError(t, book.Close())
+	s.mu.Lock()
+	_, subscribed := s.handlers[OrderbookChannel("ETH-USD", 25)]
+	s.mu.Unlock()
+	assert.False(t, subscribed)
+	fetched := atomic.LoadInt32(&fetches)
+	time.Sleep(100 * time.Millisecond)
+	assert.LessOrEqual(t, atomic.LoadInt32(&fetches), fetched+1)
+	assert.False(t, book.Synced())
+}
@@ -0,0 +1,113 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// MaxPageSize is the largest page size accepted by the listing endpoints
+const MaxPageSize = 200
+
+// Pager iterates over every page of a listing endpoint.
+//
+//	Pages are walked from the NextPageToken of the listing options (from the most recent item when empty),
+//	or backwards from its PreviousPageToken when only that one is set, and the StartDate and EndDate bounds apply to every page.
+//	Pages hold MaxPageSize items unless the listing options set a smaller PageSize.
+type Pager[T any] struct {
+	b        *Bittrex
+	endpoint string
+	query    url.Values
+	id       func(T) string
+	pageSize int
+	backward bool
+	token    string
+	done     bool
+}
+
+func newPager[T any](b *Bittrex, endpoint string, query url.Values, opts PageOpts, id func(T) string) *Pager[T] {
+	p := &Pager[T]{b: b, endpoint: endpoint, query: query, id: id, pageSize: opts.PageSize, token: opts.NextPageToken}
+	if p.pageSize <= 0 || p.pageSize > MaxPageSize {
+		p.pageSize = MaxPageSize
+	}
+	if len(opts.NextPageToken) == 0 && len(opts.PreviousPageToken) > 0 {
+		p.backward = true
+		p.token = opts.PreviousPageToken
+	}
+	p.query.Del("nextPageToken")
+	p.query.Del("previousPageToken")
+	p.query.Set("pageSize", strconv.Itoa(p.pageSize))
+	return p
+}
+
+// HasNext reports whether there may be more items to retrieve
+func (p *Pager[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next retrieves the next page, which is empty once every item has been retrieved
+func (p *Pager[T]) Next(ctx context.Context) (page []T, err error) {
+	if p.done {
+		return
+	}
+
+	q := url.Values{}
+	for key, values := range p.query {
+		q[key] = values
+	}
+	if len(p.token) > 0 {
+		if p.backward {
+			q.Set("previousPageToken", p.token)
+		} else {
+			q.Set("nextPageToken", p.token)
+		}
+	}
+
+	r, err := p.b.client.do(ctx, "GET", withQuery(p.endpoint, q), "", true)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(r, &page)
+	if err != nil {
+		return
+	}
+
+	if len(page) < p.pageSize {
+		p.done = true
+	}
+	if len(page) > 0 {
+		if p.backward {
+			p.token = p.id(page[0])
+		} else {
+			p.token = p.id(page[len(page)-1])
+		}
+	}
+	return
+}
+
+// ForEach calls fn with every remaining item, stopping at the first error returned by fn or by a request
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for p.HasNext() {
+		page, err := p.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range page {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// All retrieves every remaining item
+func (p *Pager[T]) All(ctx context.Context) (items []T, err error) {
+	err = p.ForEach(ctx, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return
+}
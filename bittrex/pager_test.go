@@ -0,0 +1,81 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPager_ForEach(t *testing.T) {
+	// Executions 9 to 0, most recent first
+	var executions []Execution
+	for i := 9; i >= 0; i-- {
+		executions = append(executions, Execution{ID: strconv.Itoa(i), MarketSymbol: "ETH-USD"})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "ETH-USD", q.Get("marketSymbol"))
+		pageSize, _ := strconv.Atoi(q.Get("pageSize"))
+		start := 0
+		if token := q.Get("nextPageToken"); len(token) > 0 {
+			id, _ := strconv.Atoi(token)
+			start = 10 - id
+		}
+		end := start + pageSize
+		if end > len(executions) {
+			end = len(executions)
+		}
+		_ = json.NewEncoder(w).Encode(executions[start:end])
+	}))
+	defer server.Close()
+
+	bt := New("key", "secret")
+	opts := &GetExecutionsOpts{MarketSymbol: "ETH-USD", PageOpts: PageOpts{PageSize: 3}}
+	pager := newPager(bt, server.URL+"/v3/executions", opts.query(), opts.PageOpts, func(execution Execution) string { return execution.ID })
+
+	var ids []string
+	err := pager.ForEach(context.Background(), func(execution Execution) error {
+		ids = append(ids, execution.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"9", "8", "7", "6", "5", "4", "3", "2", "1", "0"}, ids)
+	assert.False(t, pager.HasNext())
+
+	page, err := pager.Next(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, page)
+}
+
+func TestPager_PageSize(t *testing.T) {
+	bt := New("key", "secret")
+	pager := bt.GetClosedOrdersPager(&GetClosedOrdersOpts{PageOpts: PageOpts{PageSize: 1000, PreviousPageToken: "id"}})
+	assert.Equal(t, url.Values{"pageSize": []string{"200"}}, pager.query)
+	assert.True(t, pager.backward)
+	assert.Equal(t, "id", pager.token)
+}
+
+func TestPager_Context(t *testing.T) {
+	bt := newTestBittrex(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	pager := bt.GetClosedOrdersPager(&GetClosedOrdersOpts{})
+
+	// A cancelled page can be retrieved again
+	cancel()
+	_, err := pager.Next(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, pager.HasNext())
+
+	page, err := pager.Next(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, page)
+}
@@ -0,0 +1,170 @@
+package bittrex
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the request quota documented by Bittrex
+var DefaultRateLimit = RateLimit{Requests: 60, Per: time.Minute}
+
+// RateLimit is a request budget of at most Requests requests every Per
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// RateLimiter keeps requests within separate budgets for public and authenticated endpoints.
+//
+//	Requests over budget are queued rather than failed, and a 429 answer pauses the matching budget,
+//	for the Retry-After delay when the API sends one or for an exponentially increasing delay otherwise.
+//	A RateLimiter is safe for concurrent use, and can be shared by several clients using the same API key.
+type RateLimiter struct {
+	public  *bucket
+	private *bucket
+}
+
+// NewRateLimiter returns a rate limiter with the given budgets for public and authenticated endpoints
+func NewRateLimiter(public, private RateLimit) *RateLimiter {
+	return &RateLimiter{newBucket(public), newBucket(private)}
+}
+
+// NewDefaultRateLimiter returns a rate limiter using DefaultRateLimit for both public and authenticated endpoints
+func NewDefaultRateLimiter() *RateLimiter {
+	return NewRateLimiter(DefaultRateLimit, DefaultRateLimit)
+}
+
+func (l *RateLimiter) bucket(authenticated bool) *bucket {
+	if authenticated {
+		return l.private
+	}
+	return l.public
+}
+
+// Wait blocks until a request can be made within the budget, or until ctx is done.
+//
+//	It returns how long the request was queued.
+func (l *RateLimiter) Wait(ctx context.Context, authenticated bool) (time.Duration, error) {
+	b := l.bucket(authenticated)
+	wait := b.reserve(time.Now())
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return wait, nil
+	case <-ctx.Done():
+		b.cancel()
+		return 0, ctx.Err()
+	}
+}
+
+// Backoff pauses the budget after a 429 answer, for retryAfter if it is set or for an increasing delay otherwise
+func (l *RateLimiter) Backoff(authenticated bool, retryAfter time.Duration) {
+	l.bucket(authenticated).pause(time.Now(), retryAfter)
+}
+
+// Succeeded resets the backoff delay of the budget after a request went through
+func (l *RateLimiter) Succeeded(authenticated bool) {
+	l.bucket(authenticated).succeeded()
+}
+
+// bucket is a token bucket where requests reserve tokens ahead of time, so that callers are served in order
+type bucket struct {
+	mu          sync.Mutex
+	limit       RateLimit
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	backoffs    int
+}
+
+func newBucket(limit RateLimit) *bucket {
+	if limit.Requests <= 0 || limit.Per <= 0 {
+		limit = DefaultRateLimit
+	}
+	return &bucket{limit: limit, tokens: float64(limit.Requests), last: time.Now()}
+}
+
+// interval is the time needed to earn one token
+func (b *bucket) interval() time.Duration {
+	return b.limit.Per / time.Duration(b.limit.Requests)
+}
+
+func (b *bucket) refill(now time.Time) {
+	if now.Before(b.last) {
+		return
+	}
+	b.tokens += float64(now.Sub(b.last)) / float64(b.interval())
+	if burst := float64(b.limit.Requests); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+
+	wait := b.pausedUntil.Sub(now)
+	if b.tokens < 0 {
+		start := now
+		if b.last.After(start) {
+			start = b.last
+		}
+		if d := start.Sub(now) + time.Duration(-b.tokens*float64(b.interval())); d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// cancel gives back the token of a reservation which was not used
+func (b *bucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+}
+
+func (b *bucket) pause(now time.Time, retryAfter time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delay := retryAfter
+	if delay <= 0 {
+		delay = b.interval() << b.backoffs
+		if delay <= 0 || delay > b.limit.Per {
+			delay = b.limit.Per
+		} else {
+			b.backoffs++
+		}
+	}
+
+	until := now.Add(delay)
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	// No token is earned while paused
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+	if b.pausedUntil.After(b.last) {
+		b.last = b.pausedUntil
+	}
+}
+
+func (b *bucket) succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.backoffs = 0
+}
@@ -0,0 +1,55 @@
+package bittrex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{Requests: 2, Per: 100 * time.Millisecond}, RateLimit{Requests: 1, Per: time.Hour})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := limiter.Wait(ctx, false)
+		assert.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Budgets are separate, and a queued request gives up with its context
+	_, err := limiter.Wait(ctx, true)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Wait(ctx, true)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiter_Backoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	bt := New("", "")
+	bt.SetRateLimiter(NewRateLimiter(RateLimit{Requests: 100, Per: time.Second}, DefaultRateLimit))
+	_, err := bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.ErrorIs(t, err, ErrRateLimit)
+
+	start := time.Now()
+	_, err = bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
@@ -0,0 +1,75 @@
+package bittrex
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryPolicy retries a request up to 3 times, waiting from 500ms up to 10s between attempts
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: 500 * time.Millisecond, MaxBackoff: 10 * time.Second}
+
+// RetryPolicy re-issues idempotent requests (GET, HEAD and DELETE) failing with a transient network error, a 5xx or a 429 status.
+//
+//	The delay before each new attempt is drawn at random up to InitialBackoff doubled after each attempt, capped at MaxBackoff,
+//	unless the API asks for a longer one with a Retry-After header.
+//	Transient network errors are timeouts, and connections reset, refused or closed early, other errors are returned at once.
+//	Order creations are only retried when they carry a ClientOrderID, which prevents them from being executed twice:
+//	if an attempt created the order but its response was lost, the next one fails with ErrDuplicateClientOrderID,
+//	and CreateOrder then returns the existing order instead.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var (
+	jitterMu sync.Mutex
+	jitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff returns the delay to wait after the given failed attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	ceiling := p.MaxBackoff
+	if d := p.InitialBackoff << (attempt - 1); d > 0 && (ceiling <= 0 || d < ceiling) {
+		ceiling = d
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+	return time.Duration(jitter.Int63n(int64(ceiling) + 1))
+}
+
+// shouldRetry reports whether a failed attempt may succeed if issued again
+func (p *RetryPolicy) shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return isTransient(err)
+}
+
+// isTransient reports whether err is a network failure which may not happen again: a timeout, or a connection reset, refused or closed early
+func isTransient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
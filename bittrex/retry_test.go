@@ -0,0 +1,121 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 5, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 25 * time.Millisecond}
+	for i := 0; i < 100; i++ {
+		assert.LessOrEqual(t, policy.backoff(1), 10*time.Millisecond)
+		assert.LessOrEqual(t, policy.backoff(2), 20*time.Millisecond)
+		assert.LessOrEqual(t, policy.backoff(10), 25*time.Millisecond)
+	}
+}
+
+func TestRetryPolicy_Retry(t *testing.T) {
+	var calls int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sign("secret", r, server.URL+r.URL.RequestURI()), r.Header.Get("Api-Signature"))
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	bt := New("key", "secret")
+	bt.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond})
+	r, err := bt.client.do(context.Background(), "GET", server.URL+"/v3/orders/open", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", string(r))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// Non idempotent requests are not retried
+	atomic.StoreInt32(&calls, 0)
+	_, err = bt.client.do(context.Background(), "POST", server.URL+"/v3/orders", "{}", true)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Unless they are made idempotent by a client supplied key
+	atomic.StoreInt32(&calls, 0)
+	_, _, err = bt.client.doRequest(context.Background(), "POST", server.URL+"/v3/orders", `{"clientOrderId":"id"}`, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicy_ClientError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	bt := New("", "")
+	bt.SetRetryPolicy(&DefaultRetryPolicy)
+	_, err := bt.client.do(context.Background(), "GET", server.URL+"/v3/ping", "", false)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := &DefaultRetryPolicy
+	ctx := context.Background()
+	for err, retry := range map[error]bool{
+		&APIError{StatusCode: http.StatusServiceUnavailable}:                                 true,
+		&APIError{StatusCode: http.StatusTooManyRequests}:                                    true,
+		&APIError{StatusCode: http.StatusBadRequest}:                                         false,
+		&url.Error{Op: "Get", URL: "https://api.bittrex.com", Err: io.EOF}:                   true,
+		&url.Error{Op: "Get", URL: "https://api.bittrex.com", Err: syscall.ECONNRESET}:       true,
+		&url.Error{Op: "Get", URL: "https://api.bittrex.com", Err: context.DeadlineExceeded}: true,
+		io.ErrUnexpectedEOF:                    true,
+		errors.New("net/http: invalid method"): false,
+		&json.SyntaxError{}:                    false,
+	} {
+		assert.Equal(t, retry, policy.shouldRetry(ctx, err), err.Error())
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, policy.shouldRetry(cancelled, io.EOF))
+}
+
+func TestRetryPolicy_ClosedConnection(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// Close the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	bt := New("", "")
+	bt.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	_, err := bt.client.do(context.Background(), "GET", server.URL+"/v3/markets", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// An invalid request is not retried
+	_, meta, err := bt.client.doRequest(context.Background(), "GET", server.URL+"/v3/\x7f", "", false, true)
+	assert.Error(t, err)
+	assert.Equal(t, 1, meta.Attempts)
+}
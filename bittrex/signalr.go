@@ -0,0 +1,220 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// HubClient calls the methods of a SignalR hub and returns their result, it is implemented by the connections of stream clients
+type HubClient interface {
+	CallHub(hub string, method string, params ...interface{}) (json.RawMessage, error)
+}
+
+// hubConn is a websocket connection to a SignalR hub.
+//
+//	It implements the client side of the SignalR 1.5 protocol over the transport of the library client:
+//	the connection is negotiated through its http client, and the socket dialed with its websocket dialer,
+//	over ws or wss depending on the scheme of the socket base.
+type hubConn struct {
+	onClientMethod func(hub string, method string, arguments []json.RawMessage)
+	onMessageError func(err error)
+	socket         *websocket.Conn
+	done           chan struct{} // Closed when the connection is lost
+
+	write   sync.Mutex // Serializes the writes on the socket
+	mu      sync.Mutex
+	nextID  int
+	futures map[string]chan hubMessage
+	lost    bool
+}
+
+// hubMessage is a message received from a SignalR hub
+type hubMessage struct {
+	Cursor     string            `json:"C"`
+	Data       []json.RawMessage `json:"M"`
+	Result     json.RawMessage   `json:"R"`
+	Identifier string            `json:"I"`
+	Error      string            `json:"E"`
+}
+
+// dialHub negotiates a connection to the hubs of the SignalR server at host and opens its socket.
+//
+//	The callbacks are set on the returned connection before it starts reading messages.
+func dialHub(ctx context.Context, httpClient *http.Client, dialer *websocket.Dialer, scheme string, host string, hubs []string,
+	onClientMethod func(conn *hubConn, hub string, method string, arguments []json.RawMessage), onMessageError func(err error)) (*hubConn, error) {
+	negotiation := url.URL{Scheme: scheme, Host: host, Path: "/signalr/negotiate"}
+	req, err := http.NewRequestWithContext(ctx, "GET", negotiation.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("signalr negotiation failed: %s", resp.Status)
+	}
+	var params struct {
+		ConnectionToken string
+	}
+	if err := json.Unmarshal(body, &params); err != nil {
+		return nil, err
+	}
+
+	connectionData := make([]struct {
+		Name string `json:"Name"`
+	}, len(hubs))
+	for i, hub := range hubs {
+		connectionData[i].Name = hub
+	}
+	data, err := json.Marshal(connectionData)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("transport", "webSockets")
+	query.Set("clientProtocol", "1.5")
+	query.Set("connectionToken", params.ConnectionToken)
+	query.Set("connectionData", string(data))
+
+	connection := url.URL{Scheme: "wss", Host: host, Path: "/signalr/connect", RawQuery: query.Encode()}
+	if scheme == "http" {
+		connection.Scheme = "ws"
+	}
+	socket, _, err := dialer.DialContext(ctx, connection.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &hubConn{
+		onMessageError: onMessageError,
+		socket:         socket,
+		done:           make(chan struct{}),
+		nextID:         1,
+		futures:        map[string]chan hubMessage{},
+	}
+	conn.onClientMethod = func(hub string, method string, arguments []json.RawMessage) {
+		onClientMethod(conn, hub, method, arguments)
+	}
+	go conn.dispatch()
+	return conn, nil
+}
+
+// dispatch reads the messages of the socket until it is closed, routing hub call responses and client method calls
+func (c *hubConn) dispatch() {
+	defer c.close()
+
+	for {
+		_, data, err := c.socket.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message hubMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			if c.onMessageError != nil {
+				c.onMessageError(err)
+			}
+			continue
+		}
+		if len(message.Identifier) > 0 {
+			c.mu.Lock()
+			if future, ok := c.futures[message.Identifier]; ok {
+				future <- message
+				delete(c.futures, message.Identifier)
+			}
+			c.mu.Unlock()
+		} else if len(message.Data) == 1 {
+			var call struct {
+				Hub       string            `json:"H"`
+				Method    string            `json:"M"`
+				Arguments []json.RawMessage `json:"A"`
+			}
+			if err := json.Unmarshal(message.Data[0], &call); err == nil && len(call.Hub) > 0 && len(call.Method) > 0 && c.onClientMethod != nil {
+				c.onClientMethod(call.Hub, call.Method, call.Arguments)
+			}
+		}
+	}
+}
+
+// close closes the socket and fails the pending hub calls
+func (c *hubConn) close() {
+	c.socket.Close()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lost {
+		return
+	}
+	c.lost = true
+	for id, future := range c.futures {
+		close(future)
+		delete(c.futures, id)
+	}
+	close(c.done)
+}
+
+// CallHub calls a method of a hub and waits for its result
+func (c *hubConn) CallHub(hub string, method string, params ...interface{}) (json.RawMessage, error) {
+	c.mu.Lock()
+	if c.lost {
+		c.mu.Unlock()
+		return nil, errors.New("connection lost")
+	}
+	id := c.nextID
+	c.nextID++
+	// Buffered so that the dispatch loop never waits for the caller
+	future := make(chan hubMessage, 1)
+	c.futures[strconv.Itoa(id)] = future
+	c.mu.Unlock()
+
+	data, err := json.Marshal(struct {
+		Hub        string        `json:"H"`
+		Method     string        `json:"M"`
+		Arguments  []interface{} `json:"A"`
+		Identifier int           `json:"I"`
+	}{hub, method, params, id})
+	if err == nil {
+		c.write.Lock()
+		err = c.socket.WriteMessage(websocket.TextMessage, data)
+		c.write.Unlock()
+	}
+	if err != nil {
+		c.mu.Lock()
+		delete(c.futures, strconv.Itoa(id))
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	response, ok := <-future
+	if !ok {
+		return nil, errors.New("call to server returned no result")
+	}
+	if len(response.Error) > 0 {
+		return nil, errors.New(response.Error)
+	}
+	return response.Result, nil
+}
+
+// Done returns a channel closed when the connection is lost
+func (c *hubConn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Close closes the connection
+func (c *hubConn) Close() {
+	c.socket.Close()
+}
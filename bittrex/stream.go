@@ -0,0 +1,714 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	CHANNEL_HEARTBEAT        = "heartbeat"
+	CHANNEL_MARKETSUMMARIES  = "market_summaries"
+	CHANNEL_TICKERS          = "tickers"
+	CHANNEL_BALANCE          = "balance"
+	CHANNEL_ORDER            = "order"
+	CHANNEL_EXECUTION        = "execution"
+	CHANNEL_DEPOSIT          = "deposit"
+	CHANNEL_CONDITIONALORDER = "conditional_order"
+)
+
+// CandleChannel returns the channel of the candles of a market for a candle interval.
+//
+//	Like the other channel helpers it upper-cases the market symbol, as the messages of the channel carry it.
+func CandleChannel(marketSymbol string, candleInterval string) string {
+	return "candle_" + strings.ToUpper(marketSymbol) + "_" + candleInterval
+}
+
+// MarketSummaryChannel returns the channel of the summary of a market
+func MarketSummaryChannel(marketSymbol string) string {
+	return "market_summary_" + strings.ToUpper(marketSymbol)
+}
+
+// OrderbookChannel returns the channel of the order book of a market at a given depth (1, 25 or 500)
+func OrderbookChannel(marketSymbol string, depth int) string {
+	return "orderbook_" + strings.ToUpper(marketSymbol) + "_" + strconv.Itoa(depth)
+}
+
+// TickerChannel returns the channel of the ticker of a market
+func TickerChannel(marketSymbol string) string {
+	return "ticker_" + strings.ToUpper(marketSymbol)
+}
+
+// TradeChannel returns the channel of the trades of a market
+func TradeChannel(marketSymbol string) string {
+	return "trade_" + strings.ToUpper(marketSymbol)
+}
+
+// isPrivateChannel reports whether a channel requires an authenticated connection
+func isPrivateChannel(channel string) bool {
+	switch channel {
+	case CHANNEL_BALANCE, CHANNEL_ORDER, CHANNEL_EXECUTION, CHANNEL_DEPOSIT, CHANNEL_CONDITIONALORDER:
+		return true
+	}
+	return false
+}
+
+var errUnsupportedStream = errors.New("unsupported message type")
+
+// channelOf returns the channel on which a decoded message of a stream was published, and its sequence number (0 if it has none)
+func channelOf(stream string, data []byte) (channel string, sequence int, err error) {
+	var key struct {
+		Sequence     int    `json:"sequence"`
+		MarketSymbol string `json:"marketSymbol"`
+		Symbol       string `json:"symbol"`
+		Interval     string `json:"interval"`
+		Depth        int    `json:"depth"`
+	}
+
+	switch stream {
+	case STREAM_CANDLE, STREAM_ORDERBOOK, STREAM_TICKER, STREAM_TRADE, STREAM_MARKETSUMMARY,
+		STREAM_MARKETSUMMARIES, STREAM_TICKERS,
+		STREAM_BALANCE, STREAM_ORDER, STREAM_EXECUTION, STREAM_DEPOSIT, STREAM_CONDITIONALORDER:
+	default:
+		return "", 0, errUnsupportedStream
+	}
+	if err = json.Unmarshal(data, &key); err != nil {
+		return "", 0, err
+	}
+
+	switch stream {
+	case STREAM_CANDLE:
+		channel = CandleChannel(key.MarketSymbol, key.Interval)
+	case STREAM_ORDERBOOK:
+		channel = OrderbookChannel(key.MarketSymbol, key.Depth)
+	case STREAM_TICKER:
+		channel = TickerChannel(key.Symbol)
+	case STREAM_TRADE:
+		channel = TradeChannel(key.MarketSymbol)
+	case STREAM_MARKETSUMMARY:
+		channel = MarketSummaryChannel(key.Symbol)
+	case STREAM_MARKETSUMMARIES:
+		channel = CHANNEL_MARKETSUMMARIES
+	case STREAM_TICKERS:
+		channel = CHANNEL_TICKERS
+	case STREAM_BALANCE:
+		channel = CHANNEL_BALANCE
+	case STREAM_ORDER:
+		channel = CHANNEL_ORDER
+	case STREAM_EXECUTION:
+		channel = CHANNEL_EXECUTION
+	case STREAM_DEPOSIT:
+		channel = CHANNEL_DEPOSIT
+	case STREAM_CONDITIONALORDER:
+		channel = CHANNEL_CONDITIONALORDER
+	}
+	return channel, key.Sequence, nil
+}
+
+// SequenceGap reports that messages of a channel were missed or received out of order.
+//
+//	Received is greater than Expected when messages were missed, and lower when a stale message was received.
+type SequenceGap struct {
+	Channel  string
+	Expected int
+	Received int
+}
+
+func (g SequenceGap) Error() string {
+	if g.Received < g.Expected {
+		return fmt.Sprintf("%s: stale message %d, expected %d", g.Channel, g.Received, g.Expected)
+	}
+	return fmt.Sprintf("%s: sequence gap, expected %d but received %d", g.Channel, g.Expected, g.Received)
+}
+
+// DefaultReconnectPolicy reconnects without limit, waiting from 1s up to 1m between attempts
+var DefaultReconnectPolicy = ReconnectPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}
+
+// ReconnectPolicy controls how a stream client reconnects when its connection drops or times out.
+//
+//	The delay before each attempt is drawn at random up to InitialBackoff doubled after each attempt, capped at MaxBackoff.
+//	The client stops after MaxAttempts consecutive failed attempts, or never gives up if MaxAttempts is 0.
+type ReconnectPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// backoff returns the delay to wait before the given attempt
+func (p *ReconnectPolicy) backoff(attempt int) time.Duration {
+	return (&RetryPolicy{InitialBackoff: p.InitialBackoff, MaxBackoff: p.MaxBackoff}).backoff(attempt)
+}
+
+// ReconnectEvent reports that a stream client reconnected and subscribed again to its channels
+type ReconnectEvent struct {
+	Err      error         // Error which dropped the connection
+	Attempts int           // Number of attempts it took to reconnect
+	Downtime time.Duration // Time spent without connection
+}
+
+// StreamHandler handles the decoded messages of a channel
+type StreamHandler func(data []byte) error
+
+// StreamClient multiplexes the subscriptions to any number of channels over a single websocket connection.
+//
+//	Channels can be subscribed and unsubscribed at any time, before or after Connect.
+//	The connection is authenticated as soon as a private channel is subscribed.
+//	Each message is routed to the handler of the channel it was published on.
+//	When the connection drops, the client reconnects according to its reconnect policy,
+//	authenticating and subscribing again to all its channels.
+type StreamClient struct {
+	b                *Bittrex
+	heartbeatTimeout time.Duration
+	connectTimeout   time.Duration
+	lastMessage      int64 // Time of the last message received, in nanoseconds, accessed atomically
+
+	call      sync.Mutex // Serializes the hub calls, the SignalR client is not safe for concurrent use
+	closeOnce sync.Once
+	closing   chan struct{}
+	errs      chan error
+	done      chan struct{}
+
+	mu                sync.Mutex
+	reconnect         *ReconnectPolicy
+	reconnects        chan<- ReconnectEvent
+	gaps              chan<- SequenceGap
+	sequences         map[string]int
+	started           bool
+	conn              *hubConn
+	authenticatedConn *hubConn
+	handlers          map[string]StreamHandler
+	err               error
+}
+
+// NewStreamClient returns a stream client using the configuration of the bittrex struct
+func (b *Bittrex) NewStreamClient() *StreamClient {
+	return &StreamClient{
+		b:                b,
+		heartbeatTimeout: time.Minute,
+		reconnect:        &DefaultReconnectPolicy,
+		closing:          make(chan struct{}),
+		errs:             make(chan error, 1),
+		done:             make(chan struct{}),
+		handlers:         map[string]StreamHandler{},
+		sequences:        map[string]int{},
+		gaps:             b.client.gapEvents,
+	}
+}
+
+// SetReconnectPolicy set the policy used to reconnect when the connection drops (nil to stop the client instead), DefaultReconnectPolicy by default
+func (s *StreamClient) SetReconnectPolicy(policy *ReconnectPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reconnect = policy
+}
+
+// SetReconnectEvents set the channel on which each reconnection is reported, events are dropped if it is full
+func (s *StreamClient) SetReconnectEvents(events chan<- ReconnectEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reconnects = events
+}
+
+// SetGapEvents set the channel on which sequence gaps are reported (the one set on the bittrex struct by default), events are dropped if it is full.
+//
+//	The last sequence number is tracked for each channel whose messages carry one,
+//	a gap is reported when a message does not follow the previous one, before it is handled.
+func (s *StreamClient) SetGapEvents(events chan<- SequenceGap) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gaps = events
+}
+
+// Connect opens the connection and subscribes to the channels subscribed so far.
+//
+//	The client stops when Close is called, Connect fails,
+//	or the connection drops (or no message is received for a minute) and cannot be re-established.
+//	A stopped client cannot be connected again.
+func (s *StreamClient) Connect() error {
+	return s.ConnectCtx(context.Background())
+}
+
+// ConnectCtx is Connect with the client bound to ctx: connecting is cancelled, and the client stopped, when ctx is done
+func (s *StreamClient) ConnectCtx(ctx context.Context) error {
+	return s.connect(ctx, 15*time.Second)
+}
+
+func (s *StreamClient) connect(ctx context.Context, timeout time.Duration) error {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return errors.New("stream client already started")
+	}
+	s.started = true
+	s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		s.finish(err)
+		return err
+	}
+
+	s.connectTimeout = timeout
+	conn, err := s.open(ctx)
+	if err != nil {
+		s.finish(err)
+		return err
+	}
+
+	go s.monitor(ctx, conn)
+	return nil
+}
+
+// open opens a new connection, authenticates it if needed and subscribes it to the heartbeat and all the channels
+func (s *StreamClient) open(ctx context.Context) (*hubConn, error) {
+	conn, err := s.dial(ctx, s.connectTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Channels subscribed from now on are subscribed on the new connection by Subscribe
+	s.mu.Lock()
+	s.conn = conn
+	channels := s.channels()
+	s.mu.Unlock()
+
+	atomic.StoreInt64(&s.lastMessage, time.Now().UnixNano())
+	if err := s.subscribeChannels(conn, channels); err != nil {
+		s.mu.Lock()
+		s.conn = nil
+		s.mu.Unlock()
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// dial opens a new connection to the hub
+func (s *StreamClient) dial(ctx context.Context, timeout time.Duration) (*hubConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	c := s.b.client
+	return dialHub(ctx, c.httpClient, c.wsDialer, c.wsScheme, c.wsHost, []string{WS_HUB}, s.onClientMethod, func(err error) {
+		c.logger.Error("message error", "error", err)
+	})
+}
+
+// monitor supervises the connections until the client stops, reconnecting when a connection is lost
+func (s *StreamClient) monitor(ctx context.Context, conn *hubConn) {
+	for {
+		err := s.watch(ctx, conn)
+		s.mu.Lock()
+		s.conn = nil
+		policy := s.reconnect
+		s.mu.Unlock()
+		conn.Close()
+
+		// Discard an error raised by the lost connection after it was already lost
+		select {
+		case <-s.errs:
+		default:
+		}
+
+		if err == nil || policy == nil || ctx.Err() != nil {
+			s.finish(err)
+			return
+		}
+
+		conn, err = s.reestablish(ctx, policy, err)
+		if conn == nil {
+			s.finish(err)
+			return
+		}
+	}
+}
+
+// watch watches a connection until it is lost, returning nil if the client is closed
+func (s *StreamClient) watch(ctx context.Context, conn *hubConn) error {
+	tick := time.NewTicker(s.heartbeatTimeout)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-s.closing:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-s.errs:
+			return err
+		case <-conn.Done():
+			return errors.New("connection lost")
+		case <-tick.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&s.lastMessage))) > s.heartbeatTimeout {
+				return errors.New("messages timeout")
+			}
+		}
+	}
+}
+
+// reestablish reconnects after the connection was lost with cause, waiting between attempts according to policy.
+//
+//	It returns a nil connection if the client is closed, ctx is done or the attempts are exhausted.
+func (s *StreamClient) reestablish(ctx context.Context, policy *ReconnectPolicy, cause error) (*hubConn, error) {
+	lost := time.Now()
+	s.b.client.logger.Warn("stream disconnected", "error", cause)
+
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-s.closing:
+			timer.Stop()
+			return nil, nil
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+
+		conn, err := s.open(ctx)
+		if err == nil {
+			event := ReconnectEvent{Err: cause, Attempts: attempt, Downtime: time.Since(lost)}
+			s.b.client.metrics.IncReconnects()
+			s.b.client.logger.Info("stream reconnected", "attempts", event.Attempts, "downtime", event.Downtime)
+			s.mu.Lock()
+			events := s.reconnects
+			s.mu.Unlock()
+			if events != nil {
+				select {
+				case events <- event:
+				default:
+				}
+			}
+			return conn, nil
+		}
+
+		s.b.client.logger.Warn("stream reconnection failed", "attempt", attempt, "error", err)
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return nil, err
+		}
+	}
+}
+
+// fail stops the client with err
+func (s *StreamClient) fail(err error) {
+	select {
+	case s.errs <- err:
+	default:
+	}
+}
+
+// finish marks the client as stopped with err
+func (s *StreamClient) finish(err error) {
+	s.mu.Lock()
+	s.conn = nil
+	s.err = err
+	s.mu.Unlock()
+	close(s.done)
+}
+
+// Close closes the connection and stops the client
+func (s *StreamClient) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+	})
+
+	s.mu.Lock()
+	started := s.started
+	s.started = true
+	s.mu.Unlock()
+	if !started {
+		s.finish(nil)
+	}
+	<-s.done
+}
+
+// Done returns a channel closed when the client stops
+func (s *StreamClient) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the error which stopped the client, nil if it was closed or is still running
+func (s *StreamClient) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Subscribe subscribes to a channel, passing each message published on it to handle.
+//
+//	If the client is not connected yet, the subscription is made on Connect.
+func (s *StreamClient) Subscribe(channel string, handle StreamHandler) error {
+	if isPrivateChannel(channel) && (len(s.b.client.apiKey) == 0 || len(s.b.client.apiSecret) == 0) {
+		return errors.New("you need to set API Key and API Secret to call this method")
+	}
+
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+		return errors.New("stream client stopped")
+	default:
+	}
+	if _, ok := s.handlers[channel]; ok {
+		s.mu.Unlock()
+		return fmt.Errorf("already subscribed to %s", channel)
+	}
+	s.handlers[channel] = handle
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	if err := s.subscribeChannels(conn, []string{channel}); err != nil {
+		s.mu.Lock()
+		delete(s.handlers, channel)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribes from channels
+func (s *StreamClient) Unsubscribe(channels ...string) error {
+	s.mu.Lock()
+	for _, channel := range channels {
+		delete(s.handlers, channel)
+		delete(s.sequences, channel)
+	}
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil || len(channels) == 0 {
+		return nil
+	}
+	return s.callHub(conn, "Unsubscribe", channels)
+}
+
+// channels returns the heartbeat and the subscribed channels, s.mu must be held
+func (s *StreamClient) channels() []string {
+	channels := make([]string, 0, len(s.handlers)+1)
+	for channel := range s.handlers {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return append([]string{CHANNEL_HEARTBEAT}, channels...)
+}
+
+// subscribeChannels subscribes a connection to channels, authenticating it first if one of them is private
+func (s *StreamClient) subscribeChannels(conn *hubConn, channels []string) error {
+	for _, channel := range channels {
+		if !isPrivateChannel(channel) {
+			continue
+		}
+		s.mu.Lock()
+		authenticated := s.authenticatedConn == conn
+		s.mu.Unlock()
+		if !authenticated {
+			if err := s.authenticate(conn); err != nil {
+				return err
+			}
+		}
+		break
+	}
+	return s.callHub(conn, "Subscribe", channels)
+}
+
+// authenticate authenticates a connection
+func (s *StreamClient) authenticate(conn *hubConn) error {
+	s.call.Lock()
+	err := s.b.Authentication(conn)
+	s.call.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.authenticatedConn = conn
+	s.mu.Unlock()
+	return nil
+}
+
+// callHub calls a subscription method of the hub for channels, checking the response of each channel
+func (s *StreamClient) callHub(conn *hubConn, method string, channels []string) error {
+	s.call.Lock()
+	r, err := conn.CallHub(WS_HUB, method, channels)
+	s.call.Unlock()
+	if err != nil {
+		return err
+	}
+
+	var responses []Response
+	err = json.Unmarshal(r, &responses)
+	if err != nil {
+		return err
+	}
+	for i, response := range responses {
+		if !response.Success && i < len(channels) {
+			return fmt.Errorf("%s %s: %v", method, channels[i], response.ErrorCode)
+		}
+	}
+	return nil
+}
+
+// onClientMethod handles the messages pushed by the hub on a connection
+func (s *StreamClient) onClientMethod(conn *hubConn, hub string, stream string, messages []json.RawMessage) {
+	if hub != WS_HUB {
+		return
+	}
+	atomic.StoreInt64(&s.lastMessage, time.Now().UnixNano())
+
+	switch stream {
+	case STREAM_HEARTBEAT:
+	case STREAM_AUTHENTICATIONEXPIRING:
+		// CallHub waits for the dispatch loop running this callback, so authenticate from another goroutine
+		go func() {
+			if err := s.authenticate(conn); err != nil {
+				s.mu.Lock()
+				current := s.conn == conn
+				s.mu.Unlock()
+				if current {
+					s.fail(err)
+				}
+			}
+		}()
+	default:
+		s.route(stream, messages)
+	}
+}
+
+// route decodes the messages received on a stream and passes each of them to the handler of its channel
+func (s *StreamClient) route(stream string, messages []json.RawMessage) {
+	for _, msg := range messages {
+		s.b.client.metrics.IncMessages(stream)
+		out, err := decodeMessage(msg)
+		if err != nil {
+			s.b.client.metrics.IncDecodeErrors(stream)
+			s.b.client.logger.Warn("decode error", "stream", stream, "error", err, "message", string(msg))
+			continue
+		}
+		if len(out) == 0 {
+			continue
+		}
+
+		channel, sequence, err := channelOf(stream, out)
+		if err == errUnsupportedStream {
+			s.b.client.logDebug("unsupported message type", "stream", stream)
+			return
+		}
+		if err != nil {
+			s.b.client.metrics.IncDecodeErrors(stream)
+			s.b.client.logger.Warn("unmarshal error", "stream", stream, "error", err)
+			continue
+		}
+
+		s.mu.Lock()
+		handle, ok := s.handlers[channel]
+		var gap *SequenceGap
+		if ok {
+			gap = s.track(channel, sequence)
+		}
+		events := s.gaps
+		s.mu.Unlock()
+		if !ok {
+			s.b.client.logDebug("message on an unsubscribed channel", "stream", stream, "channel", channel)
+			continue
+		}
+
+		if gap != nil {
+			s.b.client.logger.Warn("sequence gap", "stream", stream, "channel", channel, "sequence", sequence, "expected", gap.Expected)
+			if events != nil {
+				select {
+				case events <- *gap:
+				default:
+				}
+			}
+		}
+
+		if err := handle(out); err != nil {
+			s.b.client.metrics.IncDecodeErrors(stream)
+			s.b.client.logger.Warn("unmarshal error", "stream", stream, "channel", channel, "error", err)
+		}
+	}
+}
+
+// track records the sequence of a message received on a channel, returning the gap with the previous one if any, s.mu must be held
+func (s *StreamClient) track(channel string, sequence int) *SequenceGap {
+	if sequence == 0 {
+		return nil
+	}
+	last, ok := s.sequences[channel]
+	if sequence > last {
+		s.sequences[channel] = sequence
+	}
+	if ok && sequence != last+1 {
+		return &SequenceGap{Channel: channel, Expected: last + 1, Received: sequence}
+	}
+	return nil
+}
+
+// SubscribeCandles subscribes to the candles of a market, see SubscribeCandleUpdatesWithOpts
+func (s *StreamClient) SubscribeCandles(marketSymbol string, candleInterval string, candles chan<- Candle) error {
+	return s.Subscribe(CandleChannel(marketSymbol, candleInterval), s.b.candleHandler(marketSymbol, candles))
+}
+
+// SubscribeMarketSummaries subscribes to the summaries of all markets, see SubscribeMarketSummariesUpdates
+func (s *StreamClient) SubscribeMarketSummaries(marketSummaries chan<- MarketSummary) error {
+	return s.Subscribe(CHANNEL_MARKETSUMMARIES, s.b.marketSummariesHandler(marketSummaries))
+}
+
+// SubscribeMarketSummary subscribes to the summary of a market, see SubscribeMarketSummaryUpdates
+func (s *StreamClient) SubscribeMarketSummary(marketSymbol string, marketSummaries chan<- MarketSummary) error {
+	return s.Subscribe(MarketSummaryChannel(marketSymbol), s.b.marketSummaryHandler(marketSymbol, marketSummaries))
+}
+
+// SubscribeOrderbook subscribes to the order book changes of a market, see SubscribeOrderbookUpdatesWithOpts
+func (s *StreamClient) SubscribeOrderbook(marketSymbol string, depth int, orderbooks chan<- OrderBook) error {
+	return s.Subscribe(OrderbookChannel(marketSymbol, depth), s.b.orderbookHandler(marketSymbol, orderbooks))
+}
+
+// SubscribeTickers subscribes to the tickers of all markets, see SubscribeTickersUpdates
+func (s *StreamClient) SubscribeTickers(tickers chan<- Ticker) error {
+	return s.Subscribe(CHANNEL_TICKERS, s.b.tickersHandler(tickers))
+}
+
+// SubscribeTicker subscribes to the ticker of a market, see SubscribeTickerUpdates
+func (s *StreamClient) SubscribeTicker(marketSymbol string, tickers chan<- Ticker) error {
+	return s.Subscribe(TickerChannel(marketSymbol), s.b.tickerHandler(marketSymbol, tickers))
+}
+
+// SubscribeTrades subscribes to the trades of a market, see SubscribeTradeUpdates
+func (s *StreamClient) SubscribeTrades(marketSymbol string, trades chan<- Trade) error {
+	return s.Subscribe(TradeChannel(marketSymbol), s.b.tradeHandler(marketSymbol, trades))
+}
+
+// SubscribeBalances subscribes to the balance changes of the authenticated account
+func (s *StreamClient) SubscribeBalances(balances chan<- BalanceDelta) error {
+	return s.Subscribe(CHANNEL_BALANCE, s.b.balanceHandler(balances))
+}
+
+// SubscribeOrders subscribes to the order changes of the authenticated account
+func (s *StreamClient) SubscribeOrders(orders chan<- OrderDelta) error {
+	return s.Subscribe(CHANNEL_ORDER, s.b.orderHandler(orders))
+}
+
+// SubscribeExecutions subscribes to the executions of the authenticated account
+func (s *StreamClient) SubscribeExecutions(executions chan<- ExecutionDelta) error {
+	return s.Subscribe(CHANNEL_EXECUTION, s.b.executionHandler(executions))
+}
+
+// SubscribeDeposits subscribes to the deposits of the authenticated account
+func (s *StreamClient) SubscribeDeposits(deposits chan<- DepositDelta) error {
+	return s.Subscribe(CHANNEL_DEPOSIT, s.b.depositHandler(deposits))
+}
+
+// SubscribeConditionalOrders subscribes to the conditional order changes of the authenticated account
+func (s *StreamClient) SubscribeConditionalOrders(conditionalOrders chan<- ConditionalOrderDelta) error {
+	return s.Subscribe(CHANNEL_CONDITIONALORDER, s.b.conditionalOrderHandler(conditionalOrders))
+}
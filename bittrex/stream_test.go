@@ -0,0 +1,379 @@
+package bittrex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeHub is a minimal SignalR hub answering hub calls and pushing messages
+type fakeHub struct {
+	server    *httptest.Server
+	connected chan struct{}
+	mu        sync.Mutex
+	conn      *websocket.Conn
+	calls     []string
+}
+
+// newFakeHub starts a fake hub serving plain websockets until the test ends
+func newFakeHub(t *testing.T) *fakeHub {
+	return startFakeHub(t, false)
+}
+
+// startFakeHub starts a fake hub, over TLS if secure is set, until the test ends
+func startFakeHub(t *testing.T, secure bool) *fakeHub {
+	h := &fakeHub{connected: make(chan struct{}, 10)}
+	upgrader := websocket.Upgrader{}
+	h.server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/signalr/negotiate":
+			_, _ = w.Write([]byte(`{"ConnectionToken":"token","TryWebSockets":true}`))
+		case "/signalr/connect":
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			h.mu.Lock()
+			h.conn = conn
+			h.mu.Unlock()
+			h.connected <- struct{}{}
+			h.serve(conn)
+		}
+	}))
+	if secure {
+		h.server.StartTLS()
+	} else {
+		h.server.Start()
+	}
+	t.Cleanup(func() {
+		h.drop()
+		h.server.Close()
+	})
+	return h
+}
+
+// serve answers the hub calls made on a connection
+func (h *fakeHub) serve(conn *websocket.Conn) {
+	for {
+		var call struct {
+			M string
+			A []json.RawMessage
+			I int
+		}
+		if err := conn.ReadJSON(&call); err != nil {
+			return
+		}
+
+		var result interface{} = Response{Success: true}
+		if call.M != "Authenticate" {
+			var channels []string
+			_ = json.Unmarshal(call.A[0], &channels)
+			responses := make([]Response, len(channels))
+			for i := range responses {
+				responses[i].Success = true
+			}
+			result = responses
+			call.M += " " + strings.Join(channels, ",")
+		}
+
+		h.mu.Lock()
+		h.calls = append(h.calls, call.M)
+		_ = conn.WriteJSON(map[string]interface{}{"I": strconv.Itoa(call.I), "R": result})
+		h.mu.Unlock()
+	}
+}
+
+// push publishes a message on a stream
+func (h *fakeHub) push(stream string, payload string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_ = h.conn.WriteJSON(map[string]interface{}{
+		"M": []interface{}{map[string]interface{}{"H": WS_HUB, "M": stream, "A": []json.RawMessage{encodeMessage(payload)}}},
+	})
+}
+
+// drop closes the current connection
+func (h *fakeHub) drop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.conn != nil {
+		h.conn.Close()
+	}
+}
+
+// takeCalls returns and forgets the hub calls received so far
+func (h *fakeHub) takeCalls() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	calls := h.calls
+	h.calls = nil
+	return calls
+}
+
+func newFakeHubClient(t *testing.T, h *fakeHub) *StreamClient {
+	bt := New("key", "secret")
+	assert.NoError(t, bt.SetSocketBase(strings.Replace(h.server.URL, "http://", "ws://", 1)))
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestStreamClient_ChannelOf(t *testing.T) {
+	for _, test := range []struct {
+		stream   string
+		data     string
+		channel  string
+		sequence int
+	}{
+		{STREAM_CANDLE, `{"sequence":1,"marketSymbol":"ETH-USD","interval":"MINUTE_5"}`, "candle_ETH-USD_MINUTE_5", 1},
+		{STREAM_ORDERBOOK, `{"marketSymbol":"ETH-USD","depth":25,"sequence":1}`, "orderbook_ETH-USD_25", 1},
+		{STREAM_TICKER, `{"symbol":"ETH-USD","lastTradeRate":"1500"}`, "ticker_ETH-USD", 0},
+		{STREAM_TRADE, `{"deltas":[],"sequence":1,"marketSymbol":"ETH-USD"}`, "trade_ETH-USD", 1},
+		{STREAM_MARKETSUMMARY, `{"symbol":"ETH-USD"}`, "market_summary_ETH-USD", 0},
+		{STREAM_MARKETSUMMARIES, `{"sequence":1,"deltas":[]}`, CHANNEL_MARKETSUMMARIES, 1},
+		{STREAM_TICKERS, `{"sequence":1,"deltas":[]}`, CHANNEL_TICKERS, 1},
+		{STREAM_CONDITIONALORDER, `{"accountId":"account","sequence":1}`, CHANNEL_CONDITIONALORDER, 1},
+	} {
+		channel, sequence, err := channelOf(test.stream, []byte(test.data))
+		assert.NoError(t, err)
+		assert.Equal(t, test.channel, channel)
+		assert.Equal(t, test.sequence, sequence)
+	}
+
+	_, _, err := channelOf("unknown", []byte(`{}`))
+	assert.ErrorIs(t, err, errUnsupportedStream)
+	_, _, err = channelOf(STREAM_TICKER, []byte(`[]`))
+	assert.Error(t, err)
+}
+
+func TestStreamClient_Route(t *testing.T) {
+	bt := New("", "")
+	s := bt.NewStreamClient()
+	eth := make(chan Ticker, 1)
+	btc := make(chan Ticker, 1)
+	trades := make(chan Trade, 2)
+	assert.NoError(t, s.SubscribeTicker("ETH-USD", eth))
+	assert.NoError(t, s.SubscribeTicker("BTC-USD", btc))
+	assert.NoError(t, s.SubscribeTrades("ETH-USD", trades))
+	assert.Error(t, s.SubscribeTicker("ETH-USD", eth))
+	assert.Equal(t, []string{"heartbeat", "ticker_BTC-USD", "ticker_ETH-USD", "trade_ETH-USD"}, s.channels())
+
+	s.route(STREAM_TICKER, []json.RawMessage{
+		encodeMessage(`{"symbol":"BTC-USD","lastTradeRate":"60000"}`),
+		encodeMessage(`{"symbol":"ETH-USD","lastTradeRate":"1500"}`),
+	})
+	s.route(STREAM_TRADE, []json.RawMessage{
+		encodeMessage(`{"deltas":[{"id":"1","quantity":"1","rate":"1500","takerSide":"BUY"},{"id":"2","quantity":"2","rate":"1501","takerSide":"SELL"}],"sequence":7,"marketSymbol":"ETH-USD"}`),
+	})
+	assert.Equal(t, "1500", (<-eth).LastTradeRate.String())
+	assert.Equal(t, "60000", (<-btc).LastTradeRate.String())
+	assert.Equal(t, "1", (<-trades).ID)
+	assert.Equal(t, "2", (<-trades).ID)
+
+	assert.NoError(t, s.Unsubscribe(TickerChannel("ETH-USD")))
+	s.route(STREAM_TICKER, []json.RawMessage{encodeMessage(`{"symbol":"ETH-USD","lastTradeRate":"1501"}`)})
+	assert.Len(t, eth, 0)
+}
+
+func TestStreamClient_LowerCaseSymbol(t *testing.T) {
+	assert.Equal(t, "candle_ETH-USD_MINUTE_1", CandleChannel("eth-usd", INTERVAL_MINUTE1))
+	assert.Equal(t, "market_summary_ETH-USD", MarketSummaryChannel("eth-usd"))
+	assert.Equal(t, "orderbook_ETH-USD_25", OrderbookChannel("eth-usd", 25))
+	assert.Equal(t, "ticker_ETH-USD", TickerChannel("eth-usd"))
+	assert.Equal(t, "trade_ETH-USD", TradeChannel("eth-usd"))
+
+	// Messages carry the upper-case symbol
+	s := New("", "").NewStreamClient()
+	tickers := make(chan Ticker, 1)
+	assert.NoError(t, s.SubscribeTicker("eth-usd", tickers))
+	s.route(STREAM_TICKER, []json.RawMessage{encodeMessage(`{"symbol":"ETH-USD","lastTradeRate":"1500"}`)})
+	assert.Len(t, tickers, 1)
+}
+
+func TestStreamClient_SequenceGaps(t *testing.T) {
+	bt := New("", "")
+	bt.SetLogger(nil)
+	s := bt.NewStreamClient()
+	gaps := make(chan SequenceGap, 2)
+	s.SetGapEvents(gaps)
+	trades := make(chan Trade, 10)
+	assert.NoError(t, s.SubscribeTrades("ETH-USD", trades))
+	assert.NoError(t, s.SubscribeTrades("BTC-USD", trades))
+
+	trade := func(market string, sequence int) {
+		s.route(STREAM_TRADE, []json.RawMessage{
+			encodeMessage(`{"deltas":[{"id":"1"}],"sequence":` + strconv.Itoa(sequence) + `,"marketSymbol":"` + market + `"}`),
+		})
+	}
+	trade("ETH-USD", 7)
+	trade("BTC-USD", 3)
+	trade("ETH-USD", 8)
+	assert.Len(t, gaps, 0)
+	assert.Equal(t, 7, (<-trades).Sequence)
+
+	trade("ETH-USD", 10)
+	assert.Equal(t, SequenceGap{Channel: "trade_ETH-USD", Expected: 9, Received: 10}, <-gaps)
+	trade("ETH-USD", 9)
+	gap := <-gaps
+	assert.Equal(t, SequenceGap{Channel: "trade_ETH-USD", Expected: 11, Received: 9}, gap)
+	assert.EqualError(t, gap, "trade_ETH-USD: stale message 9, expected 11")
+	trade("ETH-USD", 11)
+	assert.Len(t, gaps, 0)
+	assert.Len(t, trades, 5)
+
+	assert.NoError(t, s.Unsubscribe(TradeChannel("ETH-USD")))
+	assert.NoError(t, s.SubscribeTrades("ETH-USD", trades))
+	trade("ETH-USD", 20)
+	assert.Len(t, gaps, 0)
+}
+
+func TestStreamClient_SequenceGapsOfSubscribeUpdates(t *testing.T) {
+	h := newFakeHub(t)
+	bt := New("", "")
+	assert.NoError(t, bt.SetSocketBase(strings.Replace(h.server.URL, "http://", "ws://", 1)))
+	bt.SetLogger(nil)
+	gaps := make(chan SequenceGap, 1)
+	bt.SetGapEvents(gaps)
+
+	trades := make(chan Trade, 2)
+	stop := make(chan bool)
+	errs := make(chan error)
+	go func() {
+		errs <- bt.SubscribeTradeUpdates("ETH-USD", trades, stop)
+	}()
+	<-h.connected
+	assert.Eventually(t, func() bool { return len(h.takeCalls()) > 0 }, time.Second, time.Millisecond)
+
+	h.push(STREAM_TRADE, `{"deltas":[{"id":"1"}],"sequence":1,"marketSymbol":"ETH-USD"}`)
+	h.push(STREAM_TRADE, `{"deltas":[{"id":"3"}],"sequence":3,"marketSymbol":"ETH-USD"}`)
+	assert.Equal(t, SequenceGap{Channel: "trade_ETH-USD", Expected: 2, Received: 3}, <-gaps)
+	assert.Equal(t, 1, (<-trades).Sequence)
+	assert.Equal(t, 3, (<-trades).Sequence)
+
+	stop <- true
+	assert.EqualError(t, <-errs, "client.stop")
+}
+
+func TestStreamClient_PrivateChannelsRequireCredentials(t *testing.T) {
+	s := New("", "").NewStreamClient()
+	assert.Error(t, s.SubscribeBalances(make(chan BalanceDelta)))
+	assert.Error(t, s.SubscribeOrders(make(chan OrderDelta)))
+	assert.NoError(t, New("key", "secret").NewStreamClient().SubscribeExecutions(make(chan ExecutionDelta)))
+}
+
+func TestStreamClient_Lifecycle(t *testing.T) {
+	s := New("", "").NewStreamClient()
+	s.Close()
+	<-s.Done()
+	assert.NoError(t, s.Err())
+	assert.Error(t, s.Connect())
+	assert.Error(t, s.SubscribeTickers(make(chan Ticker)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s = New("", "").NewStreamClient()
+	assert.ErrorIs(t, s.ConnectCtx(ctx), context.Canceled)
+	<-s.Done()
+	assert.ErrorIs(t, s.Err(), context.Canceled)
+	s.Close()
+}
+
+func TestStreamClient_Connect(t *testing.T) {
+	h := newFakeHub(t)
+	s := newFakeHubClient(t, h)
+
+	tickers := make(chan Ticker, 1)
+	assert.NoError(t, s.SubscribeTicker("ETH-USD", tickers))
+	assert.NoError(t, s.Connect())
+	assert.Equal(t, []string{"Subscribe heartbeat,ticker_ETH-USD"}, h.takeCalls())
+
+	h.push(STREAM_TICKER, `{"symbol":"ETH-USD","lastTradeRate":"1500"}`)
+	assert.Equal(t, "1500", (<-tickers).LastTradeRate.String())
+
+	balances := make(chan BalanceDelta, 1)
+	assert.NoError(t, s.SubscribeBalances(balances))
+	assert.Equal(t, []string{"Authenticate", "Subscribe balance"}, h.takeCalls())
+	h.push(STREAM_BALANCE, `{"accountId":"account","sequence":1,"delta":{"currencySymbol":"BTC","total":"1"}}`)
+	assert.Equal(t, "BTC", (<-balances).Delta.CurrencySymbol)
+
+	assert.NoError(t, s.Unsubscribe(TickerChannel("ETH-USD")))
+	assert.Equal(t, []string{"Unsubscribe ticker_ETH-USD"}, h.takeCalls())
+
+	s.Close()
+	assert.NoError(t, s.Err())
+}
+
+func TestStreamClient_ConnectTLS(t *testing.T) {
+	h := startFakeHub(t, true)
+	bt, err := NewWithOptions(
+		WithHTTPClient(h.server.Client()),
+		WithWebsocketDialer(&websocket.Dialer{TLSClientConfig: h.server.Client().Transport.(*http.Transport).TLSClientConfig}),
+		WithSocketBase(h.server.URL),
+		WithLogger(nil),
+	)
+	assert.NoError(t, err)
+	s := bt.NewStreamClient()
+	defer s.Close()
+
+	tickers := make(chan Ticker, 1)
+	assert.NoError(t, s.SubscribeTicker("ETH-USD", tickers))
+	assert.NoError(t, s.Connect())
+	h.push(STREAM_TICKER, `{"symbol":"ETH-USD","lastTradeRate":"1500"}`)
+	assert.Equal(t, "1500", (<-tickers).LastTradeRate.String())
+}
+
+func TestStreamClient_Reconnect(t *testing.T) {
+	h := newFakeHub(t)
+	s := newFakeHubClient(t, h)
+	s.SetReconnectPolicy(&ReconnectPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+	events := make(chan ReconnectEvent, 1)
+	s.SetReconnectEvents(events)
+
+	tickers := make(chan Ticker, 1)
+	assert.NoError(t, s.SubscribeTicker("ETH-USD", tickers))
+	assert.NoError(t, s.SubscribeBalances(make(chan BalanceDelta, 1)))
+	assert.NoError(t, s.Connect())
+	<-h.connected
+	assert.Equal(t, []string{"Authenticate", "Subscribe heartbeat,balance,ticker_ETH-USD"}, h.takeCalls())
+
+	h.drop()
+	select {
+	case event := <-events:
+		assert.Error(t, event.Err)
+		assert.Equal(t, 1, event.Attempts)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reconnection")
+	}
+	<-h.connected
+	assert.Equal(t, []string{"Authenticate", "Subscribe heartbeat,balance,ticker_ETH-USD"}, h.takeCalls())
+
+	h.push(STREAM_TICKER, `{"symbol":"ETH-USD","lastTradeRate":"1501"}`)
+	assert.Equal(t, "1501", (<-tickers).LastTradeRate.String())
+}
+
+func TestStreamClient_Disconnect(t *testing.T) {
+	h := newFakeHub(t)
+	s := newFakeHubClient(t, h)
+	s.SetReconnectPolicy(nil)
+
+	assert.NoError(t, s.SubscribeTickers(make(chan Ticker)))
+	assert.NoError(t, s.Connect())
+	<-h.connected
+	h.drop()
+	select {
+	case <-s.Done():
+		assert.Error(t, s.Err())
+	case <-time.After(5 * time.Second):
+		t.Fatal("client not stopped")
+	}
+}
@@ -3,6 +3,7 @@ package bittrex
 import (
 	"bytes"
 	"compress/zlib"
+	"context"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/base64"
@@ -11,13 +12,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
-	"strconv"
 	"strings"
-	"sync/atomic"
 	"time"
 
-	"github.com/alexjorgef/signalr"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +31,12 @@ const (
 	STREAM_ORDER           = "order"
 	STREAM_TRADE           = "trade"
 	STREAM_HEARTBEAT       = "heartbeat"
+
+	STREAM_BALANCE                = "balance"
+	STREAM_EXECUTION              = "execution"
+	STREAM_DEPOSIT                = "deposit"
+	STREAM_CONDITIONALORDER       = "conditionalOrder"
+	STREAM_AUTHENTICATIONEXPIRING = "authenticationExpiring"
 )
 
 type Response struct {
@@ -41,38 +44,13 @@ type Response struct {
 	ErrorCode interface{} `json:"ErrorCode"`
 }
 
-// doAsyncTimeout runs f in a different goroutine
-//
-//	if f returns before timeout elapses, doAsyncTimeout returns
-//	  the result of f().
-//	  otherwise it returns "operation timeout" error, and calls tmFunc after f returns.
-func doAsyncTimeout(f func() error, tmFunc func(error), timeout time.Duration) error {
-	errs := make(chan error)
-
-	go func() {
-		err := f()
-		select {
-		case errs <- err:
-		default:
-			if tmFunc != nil {
-				tmFunc(err)
-			}
-		}
-	}()
-
-	select {
-	case err := <-errs:
-		return err
-	case <-time.After(timeout):
-		return errors.New("operation timeout")
-	}
-}
-
 // Some streams contain private data and require that you be authenticated prior to subscribing.
-func (b *Bittrex) Authentication(c *signalr.Client) error {
+//
+//	c is a connection to the websocket hub, stream clients authenticate their own connections themselves.
+func (b *Bittrex) Authentication(c HubClient) error {
 	r := &Response{}
 
-	apiTimestamp := time.Now().UnixNano() / 1000000
+	apiTimestamp := b.client.now().UnixNano() / 1000000
 	UUID := uuid.New().String()
 
 	preSign := strings.Join([]string{fmt.Sprintf("%d", apiTimestamp), UUID}, "")
@@ -102,6 +80,60 @@ func (b *Bittrex) Authentication(c *signalr.Client) error {
 	return nil
 }
 
+// decodeMessage decodes a base64 encoded and deflated stream message
+func decodeMessage(msg json.RawMessage) ([]byte, error) {
+	dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// Messages carry no zlib checksum, so the copy ends with an error once the data is inflated
+	var out bytes.Buffer
+	_, _ = io.Copy(&out, r)
+
+	return out.Bytes(), nil
+}
+
+// dropped reports a message discarded because the consumer channel of stream is full
+func (b *Bittrex) dropped(stream string, args ...interface{}) {
+	b.client.metrics.IncDroppedMessages(stream)
+	b.client.logDebug("message dropped", append([]interface{}{"stream", stream}, args...)...)
+}
+
+// subscribe opens a new connection and subscribes to a channel, blocking until the subscription ends.
+//
+//	Each message of the channel is decoded and passed to handle, errors returned by handle are logged.
+//	The subscription ends with an error when stop receives true, ctx is done, the connection drops or no message is received for a minute.
+func (b *Bittrex) subscribe(ctx context.Context, channel string, timeout time.Duration, handle StreamHandler, stop <-chan bool) error {
+	s := b.NewStreamClient()
+	s.SetReconnectPolicy(nil)
+	if err := s.Subscribe(channel, handle); err != nil {
+		return err
+	}
+	if err := s.connect(ctx, timeout); err != nil {
+		return err
+	}
+	defer s.Close()
+
+	// Blocking loop
+	for {
+		select {
+		case signal := <-stop:
+			if signal {
+				return errors.New("client.stop")
+			}
+		case <-s.Done():
+			return s.Err()
+		}
+	}
+}
+
 // Sends a message at the start of each candle (based on the subscribed interval) and when trades have occurred on the market.
 //
 //	Note that this means on an active market you will receive many updates over the course of each candle interval as trades occur.
@@ -117,109 +149,41 @@ func (b *Bittrex) SubscribeCandleUpdates(market string, candles chan<- Candle, s
 //	You will always receive an update at the start of each interval.
 //	If no trades occurred yet, this update will be a 0-volume placeholder that carries forward the Close of the previous interval as the current interval's OHLC values.
 func (b *Bittrex) SubscribeCandleUpdatesWithOpts(market string, candleInterval string, candles chan<- Candle, stop <-chan bool) error {
-	const timeout = 5 * time.Second
-	client := signalr.NewWebsocketClient()
+	return b.SubscribeCandleUpdatesWithOptsCtx(context.Background(), market, candleInterval, candles, stop)
+}
 
-	var updTime int64
+// SubscribeCandleUpdatesWithOptsCtx is SubscribeCandleUpdatesWithOpts with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeCandleUpdatesWithOptsCtx(ctx context.Context, market string, candleInterval string, candles chan<- Candle, stop <-chan bool) error {
+	return b.subscribe(ctx, CandleChannel(market, candleInterval), 5*time.Second, b.candleHandler(market, candles), stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
+// candleHandler decodes the messages of a candle channel and sends them to candles
+func (b *Bittrex) candleHandler(market string, candles chan<- Candle) StreamHandler {
+	return func(out []byte) error {
+		candleSlice := CandleSlice{}
+		err := json.Unmarshal(out, &candleSlice)
+		if err != nil {
+			return err
 		}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_CANDLE:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
-		default:
-			fmt.Printf("unsupported message type: %s %v\n", method, messages)
+		candle := Candle{
+			MarketSymbol: candleSlice.MarketSymbol,
+			Interval:     candleSlice.Interval,
+			StartsAt:     candleSlice.Delta.StartsAt,
+			Open:         candleSlice.Delta.Open,
+			High:         candleSlice.Delta.High,
+			Low:          candleSlice.Delta.Low,
+			Close:        candleSlice.Delta.Close,
+			Volume:       candleSlice.Delta.Volume,
+			QuoteVolume:  candleSlice.Delta.QuoteVolume,
+			Sequence:     candleSlice.Sequence,
 		}
-
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
-
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s \n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				candleSlice := CandleSlice{}
-				err = json.Unmarshal(out.Bytes(), &candleSlice)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				candle := Candle{
-					MarketSymbol: candleSlice.MarketSymbol,
-					Interval:     candleSlice.Interval,
-					StartsAt:     candleSlice.Delta.StartsAt,
-					Open:         candleSlice.Delta.Open,
-					High:         candleSlice.Delta.High,
-					Low:          candleSlice.Delta.Low,
-					Close:        candleSlice.Delta.Close,
-					Volume:       candleSlice.Delta.Volume,
-					QuoteVolume:  candleSlice.Delta.QuoteVolume,
-				}
-				select {
-				case candles <- candle:
-				default:
-					if b.client.debug {
-						log.Printf("candle send err: %s %d\n", market, len(candles))
-					}
-				}
-
-			}
-		}
-	}
-
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
-	}
-
-	defer client.Close()
-
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "candle_" + market + "_" + candleInterval})
-	if err != nil {
-		return err
-	}
-
-	tick := time.NewTicker(1 * time.Minute)
-
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("candle messages timeout")
-			}
+		case candles <- candle:
+		default:
+			b.dropped(STREAM_CANDLE, "market", market, "sequence", candleSlice.Sequence, "buffered", len(candles))
 		}
+		return nil
 	}
 }
 
@@ -227,107 +191,40 @@ func (b *Bittrex) SubscribeCandleUpdatesWithOpts(market string, candleInterval s
 //
 //	Market summary data is different from candles in that it is a rolling 24-hour number as opposed to data for a fixed interval like candles.
 func (b *Bittrex) SubscribeMarketSummariesUpdates(marketSummaries chan<- MarketSummary, stop <-chan bool) error {
-	const timeout = 5 * time.Second
-	client := signalr.NewWebsocketClient()
-
-	var updTime int64
-
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
-		}
-
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_MARKETSUMMARIES:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
-		default:
-			fmt.Printf("unsupported message type: %s %v\n", method, messages)
-		}
+	return b.SubscribeMarketSummariesUpdatesCtx(context.Background(), marketSummaries, stop)
+}
 
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
+// SubscribeMarketSummariesUpdatesCtx is SubscribeMarketSummariesUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeMarketSummariesUpdatesCtx(ctx context.Context, marketSummaries chan<- MarketSummary, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_MARKETSUMMARIES, 5*time.Second, b.marketSummariesHandler(marketSummaries), stop)
+}
 
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s \n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				marketSummarySlice := MarketSummarySlice{}
-				err = json.Unmarshal(out.Bytes(), &marketSummarySlice)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				for _, delta := range marketSummarySlice.Deltas {
-					marketSummary := MarketSummary{}
-					marketSummary.Symbol = delta.Symbol
-					marketSummary.High = delta.High
-					marketSummary.Low = delta.Low
-					marketSummary.Volume = delta.Volume
-					marketSummary.QuoteVolume = delta.QuoteVolume
-					marketSummary.PercentChange = delta.PercentChange
-					marketSummary.UpdatedAt = delta.UpdatedAt
-					select {
-					case marketSummaries <- marketSummary:
-					default:
-						if b.client.debug {
-							log.Printf("marketSummaries send err: %d\n", len(marketSummaries))
-						}
-					}
-				}
-			}
+// marketSummariesHandler decodes the messages of a market summaries channel and sends them to marketSummaries
+func (b *Bittrex) marketSummariesHandler(marketSummaries chan<- MarketSummary) StreamHandler {
+	return func(out []byte) error {
+		marketSummarySlice := MarketSummarySlice{}
+		err := json.Unmarshal(out, &marketSummarySlice)
+		if err != nil {
+			return err
 		}
-	}
-
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
 
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
-	}
-
-	defer client.Close()
-
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "market_summaries"})
-	if err != nil {
-		return err
-	}
-
-	tick := time.NewTicker(1 * time.Minute)
-
-	for {
-		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("marketSummaries messages timeout")
+		for _, delta := range marketSummarySlice.Deltas {
+			marketSummary := MarketSummary{}
+			marketSummary.Symbol = delta.Symbol
+			marketSummary.High = delta.High
+			marketSummary.Low = delta.Low
+			marketSummary.Volume = delta.Volume
+			marketSummary.QuoteVolume = delta.QuoteVolume
+			marketSummary.PercentChange = delta.PercentChange
+			marketSummary.UpdatedAt = delta.UpdatedAt
+			marketSummary.Sequence = marketSummarySlice.Sequence
+			select {
+			case marketSummaries <- marketSummary:
+			default:
+				b.dropped(STREAM_MARKETSUMMARIES, "market", delta.Symbol, "sequence", marketSummarySlice.Sequence, "buffered", len(marketSummaries))
 			}
 		}
+		return nil
 	}
 }
 
@@ -335,96 +232,29 @@ func (b *Bittrex) SubscribeMarketSummariesUpdates(marketSummaries chan<- MarketS
 //
 //	Market summary data is different from candles in that it is a rolling 24-hour number as opposed to data for a fixed interval like candles.
 func (b *Bittrex) SubscribeMarketSummaryUpdates(market string, marketSummaries chan<- MarketSummary, stop <-chan bool) error {
-	const timeout = 5 * time.Second
-	client := signalr.NewWebsocketClient()
-
-	var updTime int64
+	return b.SubscribeMarketSummaryUpdatesCtx(context.Background(), market, marketSummaries, stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
-		}
+// SubscribeMarketSummaryUpdatesCtx is SubscribeMarketSummaryUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeMarketSummaryUpdatesCtx(ctx context.Context, market string, marketSummaries chan<- MarketSummary, stop <-chan bool) error {
+	return b.subscribe(ctx, MarketSummaryChannel(market), 5*time.Second, b.marketSummaryHandler(market, marketSummaries), stop)
+}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_MARKETSUMMARY:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
-		default:
-			fmt.Printf("unsupported message type: %s %v\n", method, messages)
+// marketSummaryHandler decodes the messages of a market summary channel and sends them to marketSummaries
+func (b *Bittrex) marketSummaryHandler(market string, marketSummaries chan<- MarketSummary) StreamHandler {
+	return func(out []byte) error {
+		marketSummary := MarketSummary{}
+		err := json.Unmarshal(out, &marketSummary)
+		if err != nil {
+			return err
 		}
 
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
-
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s \n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				marketSummary := MarketSummary{}
-				err = json.Unmarshal(out.Bytes(), &marketSummary)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-				select {
-				case marketSummaries <- marketSummary:
-				default:
-					if b.client.debug {
-						log.Printf("marketSummary send err: %s %d\n", market, len(marketSummaries))
-					}
-				}
-			}
-		}
-	}
-
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
-	}
-
-	defer client.Close()
-
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "market_summary_" + market})
-	if err != nil {
-		return err
-	}
-
-	tick := time.NewTicker(1 * time.Minute)
-
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("marketSummary messages timeout")
-			}
+		case marketSummaries <- marketSummary:
+		default:
+			b.dropped(STREAM_MARKETSUMMARY, "market", market, "buffered", len(marketSummaries))
 		}
+		return nil
 	}
 }
 
@@ -434,415 +264,279 @@ func (b *Bittrex) SubscribeOrderbookUpdates(marketSymbol string, orderbooks chan
 }
 
 // Sends a message when there are changes to the order book within the subscribed depth.
+//
+//	Each message holds the levels which changed, a zero quantity meaning the level was removed, see LocalOrderBook to maintain the whole book.
 func (b *Bittrex) SubscribeOrderbookUpdatesWithOpts(marketSymbol string, depth int, orderbooks chan<- OrderBook, stop <-chan bool) error {
-	const timeout = 5 * time.Second
-	client := signalr.NewWebsocketClient()
+	return b.SubscribeOrderbookUpdatesWithOptsCtx(context.Background(), marketSymbol, depth, orderbooks, stop)
+}
 
-	var updTime int64
+// SubscribeOrderbookUpdatesWithOptsCtx is SubscribeOrderbookUpdatesWithOpts with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeOrderbookUpdatesWithOptsCtx(ctx context.Context, marketSymbol string, depth int, orderbooks chan<- OrderBook, stop <-chan bool) error {
+	return b.subscribe(ctx, OrderbookChannel(marketSymbol, depth), 5*time.Second, b.orderbookHandler(marketSymbol, orderbooks), stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
+// orderbookHandler decodes the messages of an order book channel and sends them to orderbooks
+func (b *Bittrex) orderbookHandler(marketSymbol string, orderbooks chan<- OrderBook) StreamHandler {
+	return func(out []byte) error {
+		orderbookSlice := OrderBookSlice{}
+		err := json.Unmarshal(out, &orderbookSlice)
+		if err != nil {
+			return err
 		}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_ORDERBOOK:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
+		orderbook := OrderBook{Symbol: orderbookSlice.MarketSymbol, Depth: orderbookSlice.Depth, Sequence: orderbookSlice.Sequence}
+		for _, delta := range orderbookSlice.AskDeltas {
+			orderbook.Ask = append(orderbook.Ask, OrderBookEntry{Quantity: delta.Quantity, Rate: delta.Rate})
+		}
+		for _, delta := range orderbookSlice.BidDeltas {
+			orderbook.Bid = append(orderbook.Bid, OrderBookEntry{Quantity: delta.Quantity, Rate: delta.Rate})
+		}
+		select {
+		case orderbooks <- orderbook:
 		default:
-			fmt.Printf("unsupported message type: %s %v\n", method, messages)
+			b.dropped(STREAM_ORDERBOOK, "market", marketSymbol, "sequence", orderbookSlice.Sequence, "buffered", len(orderbooks))
 		}
+		return nil
+	}
+}
 
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
+// Sends a message with the best bid price, best ask price, and last trade price for all markets as there are changes to the order book or trades.
+func (b *Bittrex) SubscribeTickersUpdates(tickers chan<- Ticker, stop <-chan bool) error {
+	return b.SubscribeTickersUpdatesCtx(context.Background(), tickers, stop)
+}
 
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s \n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				orderbookSlice := OrderBookSlice{}
-				err = json.Unmarshal(out.Bytes(), &orderbookSlice)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				orderbook := OrderBook{Symbol: orderbookSlice.MarketSymbol, Depth: orderbookSlice.Depth}
-				for _, delta := range orderbookSlice.AskDeltas {
-					orderbook.Ask = append(orderbook.Ask, Order{Quantity: delta.Quantity, Rate: delta.Rate})
-				}
-				for _, delta := range orderbookSlice.BidDeltas {
-					orderbook.Bid = append(orderbook.Bid, Order{Quantity: delta.Quantity, Rate: delta.Rate})
-				}
-				select {
-				case orderbooks <- orderbook:
-				default:
-					if b.client.debug {
-						log.Printf("orderbook send err: %s %d\n", marketSymbol, len(orderbooks))
-					}
-				}
+// SubscribeTickersUpdatesCtx is SubscribeTickersUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeTickersUpdatesCtx(ctx context.Context, tickers chan<- Ticker, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_TICKERS, 15*time.Second, b.tickersHandler(tickers), stop)
+}
 
-			}
+// tickersHandler decodes the messages of a tickers channel and sends them to tickers
+func (b *Bittrex) tickersHandler(tickers chan<- Ticker) StreamHandler {
+	return func(out []byte) error {
+		tickerSlice := TickerSlice{}
+		err := json.Unmarshal(out, &tickerSlice)
+		if err != nil {
+			return err
 		}
-	}
 
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
+		for _, delta := range tickerSlice.Deltas {
+			ticker := Ticker{}
+			ticker.Symbol = delta.Symbol
+			ticker.LastTradeRate = delta.LastTradeRate
+			ticker.BidRate = delta.BidRate
+			ticker.AskRate = delta.AskRate
+			ticker.Sequence = tickerSlice.Sequence
+			select {
+			case tickers <- ticker:
+			default:
+				b.dropped(STREAM_TICKERS, "market", delta.Symbol, "sequence", tickerSlice.Sequence, "buffered", len(tickers))
 			}
-		}, timeout)
-	if err != nil {
-		return err
+		}
+		return nil
 	}
+}
 
-	defer client.Close()
+// Sends a message with the best bid and ask price for the given market as well as the last trade price whenever there is a relevant change to the order book or a trade.
+func (b *Bittrex) SubscribeTickerUpdates(marketSymbol string, tickers chan<- Ticker, stop <-chan bool) error {
+	return b.SubscribeTickerUpdatesCtx(context.Background(), marketSymbol, tickers, stop)
+}
 
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "orderbook_" + marketSymbol + "_" + strconv.Itoa(depth)})
-	if err != nil {
-		return err
-	}
+// SubscribeTickerUpdatesCtx is SubscribeTickerUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeTickerUpdatesCtx(ctx context.Context, marketSymbol string, tickers chan<- Ticker, stop <-chan bool) error {
+	return b.subscribe(ctx, TickerChannel(marketSymbol), 15*time.Second, b.tickerHandler(marketSymbol, tickers), stop)
+}
 
-	tick := time.NewTicker(1 * time.Minute)
+// tickerHandler decodes the messages of a ticker channel and sends them to tickers
+func (b *Bittrex) tickerHandler(marketSymbol string, tickers chan<- Ticker) StreamHandler {
+	return func(out []byte) error {
+		ticker := Ticker{}
+		err := json.Unmarshal(out, &ticker)
+		if err != nil {
+			return err
+		}
 
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("orderook messages timeout")
-			}
+		case tickers <- ticker:
+		default:
+			b.dropped(STREAM_TICKER, "market", marketSymbol, "buffered", len(tickers))
 		}
-
+		return nil
 	}
 }
 
-// Sends a message with the best bid price, best ask price, and last trade price for all markets as there are changes to the order book or trades.
-func (b *Bittrex) SubscribeTickersUpdates(tickers chan<- Ticker, stop <-chan bool) error {
-	const timeout = 15 * time.Second
-	client := signalr.NewWebsocketClient()
+// Sends a message with the quantity and rate of trades on a market as they occur.
+func (b *Bittrex) SubscribeTradeUpdates(marketSymbol string, trades chan<- Trade, stop <-chan bool) error {
+	return b.SubscribeTradeUpdatesCtx(context.Background(), marketSymbol, trades, stop)
+}
 
-	var updTime int64
+// SubscribeTradeUpdatesCtx is SubscribeTradeUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeTradeUpdatesCtx(ctx context.Context, marketSymbol string, trades chan<- Trade, stop <-chan bool) error {
+	return b.subscribe(ctx, TradeChannel(marketSymbol), 15*time.Second, b.tradeHandler(marketSymbol, trades), stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
+// tradeHandler decodes the messages of a trade channel and sends them to trades
+func (b *Bittrex) tradeHandler(marketSymbol string, trades chan<- Trade) StreamHandler {
+	return func(out []byte) error {
+		tradeSlice := TradeSlice{}
+		err := json.Unmarshal(out, &tradeSlice)
+		if err != nil {
+			return err
 		}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_TICKERS:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
-		default:
-			fmt.Printf("unsupported message type: %s\n", method)
-		}
+		trade := Trade{Symbol: tradeSlice.MarketSymbol, Sequence: tradeSlice.Sequence}
 
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
-
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s\n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				tickerSlice := TickerSlice{}
-				err = json.Unmarshal(out.Bytes(), &tickerSlice)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				for _, delta := range tickerSlice.Deltas {
-					ticker := Ticker{}
-					ticker.Symbol = delta.Symbol
-					ticker.LastTradeRate = delta.LastTradeRate
-					ticker.BidRate = delta.BidRate
-					ticker.AskRate = delta.AskRate
-					select {
-					case tickers <- ticker:
-					default:
-						if b.client.debug {
-							log.Printf("tickers send err: %d\n", len(tickers))
-						}
-					}
-				}
+		for _, delta := range tradeSlice.Deltas {
+			trade.ID = delta.ID
+			trade.ExecutedAt = delta.ExecutedAt
+			trade.Quantity = delta.Quantity
+			trade.Rate = delta.Rate
+			trade.TakerSide = delta.TakerSide
+			select {
+			case trades <- trade:
+			default:
+				b.dropped(STREAM_TRADE, "market", marketSymbol, "sequence", tradeSlice.Sequence, "buffered", len(trades))
 			}
 		}
+		return nil
 	}
+}
 
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
-	}
-
-	defer client.Close()
+// Sends a message when changes are made to the balances of the authenticated account.
+func (b *Bittrex) SubscribeBalanceUpdates(balances chan<- BalanceDelta, stop <-chan bool) error {
+	return b.SubscribeBalanceUpdatesCtx(context.Background(), balances, stop)
+}
 
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "tickers"})
-	if err != nil {
-		return err
-	}
+// SubscribeBalanceUpdatesCtx is SubscribeBalanceUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeBalanceUpdatesCtx(ctx context.Context, balances chan<- BalanceDelta, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_BALANCE, 15*time.Second, b.balanceHandler(balances), stop)
+}
 
-	tick := time.NewTicker(1 * time.Minute)
+// balanceHandler decodes the messages of a balance channel and sends them to balances
+func (b *Bittrex) balanceHandler(balances chan<- BalanceDelta) StreamHandler {
+	return func(out []byte) error {
+		balance := BalanceDelta{}
+		err := json.Unmarshal(out, &balance)
+		if err != nil {
+			return err
+		}
 
-	// Blocking loop
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("tickers messages timeout")
-			}
+		case balances <- balance:
+		default:
+			b.dropped(STREAM_BALANCE, "sequence", balance.Sequence, "buffered", len(balances))
 		}
+		return nil
 	}
 }
 
-// Sends a message with the best bid and ask price for the given market as well as the last trade price whenever there is a relevant change to the order book or a trade.
-func (b *Bittrex) SubscribeTickerUpdates(marketSymbol string, tickers chan<- Ticker, stop <-chan bool) error {
-	const timeout = 15 * time.Second
-	client := signalr.NewWebsocketClient()
+// Sends a message when orders of the authenticated account are opened, filled or closed.
+func (b *Bittrex) SubscribeOrderUpdates(orders chan<- OrderDelta, stop <-chan bool) error {
+	return b.SubscribeOrderUpdatesCtx(context.Background(), orders, stop)
+}
 
-	var updTime int64
+// SubscribeOrderUpdatesCtx is SubscribeOrderUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeOrderUpdatesCtx(ctx context.Context, orders chan<- OrderDelta, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_ORDER, 15*time.Second, b.orderHandler(orders), stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
-		if hub != WS_HUB {
-			return
+// orderHandler decodes the messages of an order channel and sends them to orders
+func (b *Bittrex) orderHandler(orders chan<- OrderDelta) StreamHandler {
+	return func(out []byte) error {
+		order := OrderDelta{}
+		err := json.Unmarshal(out, &order)
+		if err != nil {
+			return err
 		}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_TICKER:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
+		select {
+		case orders <- order:
 		default:
-			fmt.Printf("unsupported message type: %s\n", method)
+			b.dropped(STREAM_ORDER, "sequence", order.Sequence, "buffered", len(orders))
 		}
-
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
-
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s\n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				ticker := Ticker{}
-				err = json.Unmarshal(out.Bytes(), &ticker)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				select {
-				case tickers <- ticker:
-				default:
-					if b.client.debug {
-						log.Printf("ticker send err: %s %d\n", marketSymbol, len(tickers))
-					}
-				}
-			}
-		}
-	}
-
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
+		return nil
 	}
+}
 
-	defer client.Close()
+// Sends a message with the executions (fills) of the orders of the authenticated account as they occur.
+func (b *Bittrex) SubscribeExecutionUpdates(executions chan<- ExecutionDelta, stop <-chan bool) error {
+	return b.SubscribeExecutionUpdatesCtx(context.Background(), executions, stop)
+}
 
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "ticker_" + marketSymbol})
-	if err != nil {
-		return err
-	}
+// SubscribeExecutionUpdatesCtx is SubscribeExecutionUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeExecutionUpdatesCtx(ctx context.Context, executions chan<- ExecutionDelta, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_EXECUTION, 15*time.Second, b.executionHandler(executions), stop)
+}
 
-	tick := time.NewTicker(1 * time.Minute)
+// executionHandler decodes the messages of an execution channel and sends them to executions
+func (b *Bittrex) executionHandler(executions chan<- ExecutionDelta) StreamHandler {
+	return func(out []byte) error {
+		execution := ExecutionDelta{}
+		err := json.Unmarshal(out, &execution)
+		if err != nil {
+			return err
+		}
 
-	// Blocking loop
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("ticker messages timeout")
-			}
+		case executions <- execution:
+		default:
+			b.dropped(STREAM_EXECUTION, "sequence", execution.Sequence, "buffered", len(executions))
 		}
+		return nil
 	}
 }
 
-// Sends a message with the quantity and rate of trades on a market as they occur.
-func (b *Bittrex) SubscribeTradeUpdates(marketSymbol string, trades chan<- Trade, stop <-chan bool) error {
-	const timeout = 15 * time.Second
-	client := signalr.NewWebsocketClient()
-
-	var updTime int64
+// Sends a message when a deposit to the authenticated account is detected or its status changes.
+func (b *Bittrex) SubscribeDepositUpdates(deposits chan<- DepositDelta, stop <-chan bool) error {
+	return b.SubscribeDepositUpdatesCtx(context.Background(), deposits, stop)
+}
 
-	client.OnClientMethod = func(hub string, method string, messages []json.RawMessage) {
+// SubscribeDepositUpdatesCtx is SubscribeDepositUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeDepositUpdatesCtx(ctx context.Context, deposits chan<- DepositDelta, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_DEPOSIT, 15*time.Second, b.depositHandler(deposits), stop)
+}
 
-		if hub != WS_HUB {
-			return
+// depositHandler decodes the messages of a deposit channel and sends them to deposits
+func (b *Bittrex) depositHandler(deposits chan<- DepositDelta) StreamHandler {
+	return func(out []byte) error {
+		deposit := DepositDelta{}
+		err := json.Unmarshal(out, &deposit)
+		if err != nil {
+			return err
 		}
 
-		switch method {
-		case STREAM_HEARTBEAT, STREAM_TRADE:
-			atomic.StoreInt64(&updTime, time.Now().Unix())
-
+		select {
+		case deposits <- deposit:
 		default:
-			fmt.Printf("unsupported message type: %s\n", method)
+			b.dropped(STREAM_DEPOSIT, "sequence", deposit.Sequence, "buffered", len(deposits))
 		}
-
-		for _, msg := range messages {
-			dbuf, err := base64.StdEncoding.DecodeString(strings.Trim(string(msg), `"`))
-			if err != nil {
-				fmt.Printf("DecodeString error: %s %s\n", err.Error(), string(msg))
-				continue
-			}
-
-			r, err := zlib.NewReader(bytes.NewReader(append([]byte{120, 156}, dbuf...)))
-			if err != nil {
-				fmt.Printf("unzip error %s %s\n", err.Error(), string(msg))
-				continue
-			}
-			defer r.Close()
-
-			var out bytes.Buffer
-			written, _ := io.Copy(&out, r)
-
-			if written > 0 {
-				tradeSlice := TradeSlice{}
-				err = json.Unmarshal(out.Bytes(), &tradeSlice)
-				if err != nil {
-					fmt.Printf("unmarshal error %s\n", err.Error())
-					continue
-				}
-
-				trade := Trade{Symbol: tradeSlice.MarketSymbol}
-
-				for _, delta := range tradeSlice.Deltas {
-					trade.ID = delta.ID
-					trade.ExecutedAt = delta.ExecutedAt
-					trade.Quantity = delta.Quantity
-					trade.Rate = delta.Rate
-					trade.TakerSide = delta.TakerSide
-					select {
-					case trades <- trade:
-					default:
-						if b.client.debug {
-							log.Printf("trade send err: %s %d\n", marketSymbol, len(trades))
-						}
-					}
-				}
-			}
-		}
-	}
-
-	client.OnMessageError = func(err error) {
-		fmt.Printf("ERROR OCCURRED: %s\n", err.Error())
-	}
-
-	err := doAsyncTimeout(
-		func() error {
-			return client.Connect("https", WS_BASE, []string{WS_HUB})
-		}, func(err error) {
-			if err == nil {
-				client.Close()
-			}
-		}, timeout)
-	if err != nil {
-		return err
+		return nil
 	}
+}
 
-	defer client.Close()
+// Sends a message when conditional orders of the authenticated account are created, triggered or cancelled.
+func (b *Bittrex) SubscribeConditionalOrderUpdates(conditionalOrders chan<- ConditionalOrderDelta, stop <-chan bool) error {
+	return b.SubscribeConditionalOrderUpdatesCtx(context.Background(), conditionalOrders, stop)
+}
 
-	_, err = client.CallHub(WS_HUB, "Subscribe", []interface{}{"heartbeat", "trade_" + marketSymbol})
-	if err != nil {
-		return err
-	}
+// SubscribeConditionalOrderUpdatesCtx is SubscribeConditionalOrderUpdates with the subscription bound to ctx, which ends it when done
+func (b *Bittrex) SubscribeConditionalOrderUpdatesCtx(ctx context.Context, conditionalOrders chan<- ConditionalOrderDelta, stop <-chan bool) error {
+	return b.subscribe(ctx, CHANNEL_CONDITIONALORDER, 15*time.Second, b.conditionalOrderHandler(conditionalOrders), stop)
+}
 
-	tick := time.NewTicker(1 * time.Minute)
+// conditionalOrderHandler decodes the messages of a conditional order channel and sends them to conditionalOrders
+func (b *Bittrex) conditionalOrderHandler(conditionalOrders chan<- ConditionalOrderDelta) StreamHandler {
+	return func(out []byte) error {
+		conditionalOrder := ConditionalOrderDelta{}
+		err := json.Unmarshal(out, &conditionalOrder)
+		if err != nil {
+			return err
+		}
 
-	// Blocking loop
-	for {
 		select {
-		case signal := <-stop:
-			if signal {
-				return errors.New("client.stop")
-			}
-		case <-client.DisconnectedChannel:
-			return errors.New("client.DisconnectedChannel")
-		case <-tick.C:
-			if time.Now().Unix()-atomic.LoadInt64(&updTime) > 60 {
-				return errors.New("trade messages timeout")
-			}
+		case conditionalOrders <- conditionalOrder:
+		default:
+			b.dropped(STREAM_CONDITIONALORDER, "sequence", conditionalOrder.Sequence, "buffered", len(conditionalOrders))
 		}
+		return nil
 	}
 }
@@ -42,11 +42,11 @@ type MarketSummarySlice struct {
 }
 
 type OrderBookSlice struct {
-	MarketSymbol string  `json:"marketSymbol"`
-	Depth        int     `json:"depth"`
-	Sequence     int     `json:"sequence"`
-	BidDeltas    []Order `json:"bidDeltas"`
-	AskDeltas    []Order `json:"askDeltas"`
+	MarketSymbol string           `json:"marketSymbol"`
+	Depth        int              `json:"depth"`
+	Sequence     int              `json:"sequence"`
+	BidDeltas    []OrderBookEntry `json:"bidDeltas"`
+	AskDeltas    []OrderBookEntry `json:"askDeltas"`
 }
 
 type CandleSlice struct {
@@ -63,3 +63,33 @@ type CandleSlice struct {
 		QuoteVolume decimal.Decimal `json:"quoteVolume"`
 	}
 }
+
+type BalanceDelta struct {
+	AccountID string  `json:"accountId"`
+	Sequence  int     `json:"sequence"`
+	Delta     Balance `json:"delta"`
+}
+
+type OrderDelta struct {
+	AccountID string      `json:"accountId"`
+	Sequence  int         `json:"sequence"`
+	Delta     PlacedOrder `json:"delta"`
+}
+
+type ExecutionDelta struct {
+	AccountID string      `json:"accountId"`
+	Sequence  int         `json:"sequence"`
+	Deltas    []Execution `json:"deltas"`
+}
+
+type DepositDelta struct {
+	AccountID string  `json:"accountId"`
+	Sequence  int     `json:"sequence"`
+	Delta     Deposit `json:"delta"`
+}
+
+type ConditionalOrderDelta struct {
+	AccountID string           `json:"accountId"`
+	Sequence  int              `json:"sequence"`
+	Delta     ConditionalOrder `json:"delta"`
+}
@@ -1,6 +1,11 @@
 package bittrex
 
 import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -232,3 +237,43 @@ func TestTradeStream_SubscribeTradeUpdates(t *testing.T) {
 	rate, _ := trade.Rate.Float64()
 	assert.Greater(t, rate, float64(0))
 }
+
+func TestPrivateStream_RequiresCredentials(t *testing.T) {
+	client := New("", "")
+	stopCh := make(chan bool)
+	assert.Error(t, client.SubscribeBalanceUpdates(make(chan BalanceDelta), stopCh))
+	assert.Error(t, client.SubscribeOrderUpdates(make(chan OrderDelta), stopCh))
+	assert.Error(t, client.SubscribeExecutionUpdates(make(chan ExecutionDelta), stopCh))
+	assert.Error(t, client.SubscribeDepositUpdates(make(chan DepositDelta), stopCh))
+	assert.Error(t, client.SubscribeConditionalOrderUpdates(make(chan ConditionalOrderDelta), stopCh))
+}
+
+func TestStream_Ctx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := New("", "")
+	err := client.SubscribeTickerUpdatesCtx(ctx, "ADA-USD", make(chan Ticker), make(chan bool))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// encodeMessage deflates and base64 encodes a payload the way the socket does
+func encodeMessage(payload string) json.RawMessage {
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	_, _ = w.Write([]byte(payload))
+	w.Close()
+	msg, _ := json.Marshal(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	return msg
+}
+
+func TestPrivateStream_DecodeMessage(t *testing.T) {
+	msg := encodeMessage(`{"accountId":"account","sequence":3,"delta":{"currencySymbol":"BTC","total":"1.5","available":"1"}}`)
+
+	out, err := decodeMessage(msg)
+	assert.NoError(t, err)
+	balance := BalanceDelta{}
+	assert.NoError(t, json.Unmarshal(out, &balance))
+	assert.Equal(t, 3, balance.Sequence)
+	assert.Equal(t, "BTC", balance.Delta.CurrencySymbol)
+	assert.Equal(t, "1.5", balance.Delta.Total.String())
+}